@@ -0,0 +1,422 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	// Embed the IANA database so schedule times resolve on hosts without one
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AlertOpsTimeZone is an entry in the bundled time zone catalog. AlertOps uses the
+// Windows display name; the Windows ID and IANA zones are accepted as aliases.
+type AlertOpsTimeZone struct {
+	Name      string
+	WindowsID string
+	IANA      []string
+}
+
+// Time zones supported by AlertOps
+var AlertOpsTimeZones = []AlertOpsTimeZone{
+	{"(UTC-12:00) International Date Line West", "Dateline Standard Time", []string{"Etc/GMT+12"}},
+	{"(UTC-11:00) Coordinated Universal Time-11", "UTC-11", []string{"Etc/GMT+11", "Pacific/Pago_Pago", "Pacific/Niue", "Pacific/Midway"}},
+	{"(UTC-10:00) Aleutian Islands", "Aleutian Standard Time", []string{"America/Adak"}},
+	{"(UTC-10:00) Hawaii", "Hawaiian Standard Time", []string{"Pacific/Honolulu", "Pacific/Rarotonga", "Pacific/Tahiti", "Etc/GMT+10"}},
+	{"(UTC-09:30) Marquesas Islands", "Marquesas Standard Time", []string{"Pacific/Marquesas"}},
+	{"(UTC-09:00) Alaska", "Alaskan Standard Time", []string{"America/Anchorage", "America/Juneau", "America/Nome", "America/Sitka", "America/Yakutat", "America/Metlakatla"}},
+	{"(UTC-09:00) Coordinated Universal Time-09", "UTC-09", []string{"Etc/GMT+9", "Pacific/Gambier"}},
+	{"(UTC-08:00) Baja California", "Pacific Standard Time (Mexico)", []string{"America/Tijuana"}},
+	{"(UTC-08:00) Coordinated Universal Time-08", "UTC-08", []string{"Etc/GMT+8", "Pacific/Pitcairn"}},
+	{"(UTC-08:00) Pacific Time (US & Canada)", "Pacific Standard Time", []string{"America/Los_Angeles", "America/Vancouver", "PST8PDT"}},
+	{"(UTC-07:00) Arizona", "US Mountain Standard Time", []string{"America/Phoenix", "America/Creston", "America/Dawson_Creek", "America/Fort_Nelson", "America/Hermosillo", "Etc/GMT+7"}},
+	{"(UTC-07:00) Chihuahua, La Paz, Mazatlan", "Mountain Standard Time (Mexico)", []string{"America/Mazatlan", "America/Chihuahua"}},
+	{"(UTC-07:00) Mountain Time (US & Canada)", "Mountain Standard Time", []string{"America/Denver", "America/Edmonton", "America/Boise", "America/Cambridge_Bay", "America/Inuvik", "America/Ciudad_Juarez", "MST7MDT"}},
+	{"(UTC-07:00) Yukon", "Yukon Standard Time", []string{"America/Whitehorse", "America/Dawson"}},
+	{"(UTC-06:00) Central America", "Central America Standard Time", []string{"America/Guatemala", "America/Belize", "America/Costa_Rica", "America/El_Salvador", "America/Managua", "America/Tegucigalpa", "Pacific/Galapagos", "Etc/GMT+6"}},
+	{"(UTC-06:00) Central Time (US & Canada)", "Central Standard Time", []string{"America/Chicago", "America/Winnipeg", "America/Indiana/Knox", "America/Indiana/Tell_City", "America/Menominee", "America/North_Dakota/Center", "America/North_Dakota/New_Salem", "America/North_Dakota/Beulah", "America/Rankin_Inlet", "America/Resolute", "America/Matamoros", "America/Ojinaga", "CST6CDT"}},
+	{"(UTC-06:00) Easter Island", "Easter Island Standard Time", []string{"Pacific/Easter"}},
+	{"(UTC-06:00) Guadalajara, Mexico City, Monterrey", "Central Standard Time (Mexico)", []string{"America/Mexico_City", "America/Bahia_Banderas", "America/Merida", "America/Monterrey"}},
+	{"(UTC-06:00) Saskatchewan", "Canada Central Standard Time", []string{"America/Regina", "America/Swift_Current"}},
+	{"(UTC-05:00) Bogota, Lima, Quito, Rio Branco", "SA Pacific Standard Time", []string{"America/Bogota", "America/Lima", "America/Guayaquil", "America/Rio_Branco", "America/Eirunepe", "America/Jamaica", "America/Panama", "America/Cayman", "Etc/GMT+5"}},
+	{"(UTC-05:00) Chetumal", "Eastern Standard Time (Mexico)", []string{"America/Cancun"}},
+	{"(UTC-05:00) Eastern Time (US & Canada)", "Eastern Standard Time", []string{"America/New_York", "America/Toronto", "America/Detroit", "America/Kentucky/Louisville", "America/Kentucky/Monticello", "America/Indiana/Petersburg", "America/Indiana/Vincennes", "America/Indiana/Winamac", "America/Iqaluit", "America/Nassau", "EST5EDT"}},
+	{"(UTC-05:00) Haiti", "Haiti Standard Time", []string{"America/Port-au-Prince"}},
+	{"(UTC-05:00) Havana", "Cuba Standard Time", []string{"America/Havana"}},
+	{"(UTC-05:00) Indiana (East)", "US Eastern Standard Time", []string{"America/Indiana/Indianapolis", "America/Indianapolis", "America/Indiana/Marengo", "America/Indiana/Vevay"}},
+	{"(UTC-05:00) Turks and Caicos", "Turks And Caicos Standard Time", []string{"America/Grand_Turk"}},
+	{"(UTC-04:00) Asuncion", "Paraguay Standard Time", []string{"America/Asuncion"}},
+	{"(UTC-04:00) Atlantic Time (Canada)", "Atlantic Standard Time", []string{"America/Halifax", "America/Glace_Bay", "America/Goose_Bay", "America/Moncton", "America/Thule", "Atlantic/Bermuda"}},
+	{"(UTC-04:00) Caracas", "Venezuela Standard Time", []string{"America/Caracas"}},
+	{"(UTC-04:00) Cuiaba", "Central Brazilian Standard Time", []string{"America/Cuiaba", "America/Campo_Grande"}},
+	{"(UTC-04:00) Georgetown, La Paz, Manaus, San Juan", "SA Western Standard Time", []string{"America/La_Paz", "America/Manaus", "America/Guyana", "America/Puerto_Rico", "America/Santo_Domingo", "America/Barbados", "America/Martinique", "America/Port_of_Spain", "America/Boa_Vista", "America/Porto_Velho", "Etc/GMT+4"}},
+	{"(UTC-04:00) Santiago", "Pacific SA Standard Time", []string{"America/Santiago"}},
+	{"(UTC-03:30) Newfoundland", "Newfoundland Standard Time", []string{"America/St_Johns"}},
+	{"(UTC-03:00) Araguaina", "Tocantins Standard Time", []string{"America/Araguaina"}},
+	{"(UTC-03:00) Brasilia", "E. South America Standard Time", []string{"America/Sao_Paulo"}},
+	{"(UTC-03:00) Cayenne, Fortaleza", "SA Eastern Standard Time", []string{"America/Cayenne", "America/Fortaleza", "America/Belem", "America/Maceio", "America/Recife", "America/Santarem", "America/Paramaribo", "Atlantic/Stanley", "Antarctica/Rothera", "Etc/GMT+3"}},
+	{"(UTC-03:00) City of Buenos Aires", "Argentina Standard Time", []string{"America/Argentina/Buenos_Aires", "America/Buenos_Aires", "America/Argentina/Cordoba", "America/Argentina/Mendoza", "America/Argentina/Salta", "America/Argentina/Ushuaia"}},
+	{"(UTC-03:00) Greenland", "Greenland Standard Time", []string{"America/Nuuk", "America/Godthab"}},
+	{"(UTC-03:00) Montevideo", "Montevideo Standard Time", []string{"America/Montevideo"}},
+	{"(UTC-03:00) Punta Arenas", "Magallanes Standard Time", []string{"America/Punta_Arenas"}},
+	{"(UTC-03:00) Saint Pierre and Miquelon", "Saint Pierre Standard Time", []string{"America/Miquelon"}},
+	{"(UTC-03:00) Salvador", "Bahia Standard Time", []string{"America/Bahia"}},
+	{"(UTC-02:00) Coordinated Universal Time-02", "UTC-02", []string{"Etc/GMT+2", "America/Noronha", "Atlantic/South_Georgia"}},
+	{"(UTC-01:00) Azores", "Azores Standard Time", []string{"Atlantic/Azores"}},
+	{"(UTC-01:00) Cabo Verde Is.", "Cape Verde Standard Time", []string{"Atlantic/Cape_Verde", "Etc/GMT+1"}},
+	{"(UTC) Coordinated Universal Time", "UTC", []string{"Etc/UTC", "UTC", "Etc/GMT", "GMT", "Etc/Universal", "Etc/Zulu", "America/Danmarkshavn"}},
+	{"(UTC+00:00) Dublin, Edinburgh, Lisbon, London", "GMT Standard Time", []string{"Europe/London", "Europe/Dublin", "Europe/Lisbon", "Atlantic/Canary", "Atlantic/Faroe", "Atlantic/Madeira", "Europe/Guernsey", "Europe/Isle_of_Man", "Europe/Jersey"}},
+	{"(UTC+00:00) Monrovia, Reykjavik", "Greenwich Standard Time", []string{"Atlantic/Reykjavik", "Africa/Abidjan", "Africa/Accra", "Africa/Monrovia", "Africa/Dakar", "Africa/Bamako", "Africa/Banjul", "Africa/Conakry", "Africa/Freetown", "Africa/Lome", "Africa/Nouakchott", "Africa/Ouagadougou", "Africa/Bissau", "Atlantic/St_Helena"}},
+	{"(UTC+00:00) Sao Tome", "Sao Tome Standard Time", []string{"Africa/Sao_Tome"}},
+	{"(UTC+01:00) Casablanca", "Morocco Standard Time", []string{"Africa/Casablanca", "Africa/El_Aaiun"}},
+	{"(UTC+01:00) Amsterdam, Berlin, Bern, Rome, Stockholm, Vienna", "W. Europe Standard Time", []string{"Europe/Berlin", "Europe/Amsterdam", "Europe/Rome", "Europe/Stockholm", "Europe/Vienna", "Europe/Zurich", "Europe/Oslo", "Europe/Luxembourg", "Europe/Monaco", "Europe/Malta", "Europe/Andorra", "Europe/Gibraltar", "Europe/San_Marino", "Europe/Vaduz", "Europe/Vatican", "Arctic/Longyearbyen"}},
+	{"(UTC+01:00) Belgrade, Bratislava, Budapest, Ljubljana, Prague", "Central Europe Standard Time", []string{"Europe/Budapest", "Europe/Belgrade", "Europe/Bratislava", "Europe/Ljubljana", "Europe/Prague", "Europe/Podgorica", "Europe/Tirane"}},
+	{"(UTC+01:00) Brussels, Copenhagen, Madrid, Paris", "Romance Standard Time", []string{"Europe/Paris", "Europe/Brussels", "Europe/Copenhagen", "Europe/Madrid", "Africa/Ceuta"}},
+	{"(UTC+01:00) Sarajevo, Skopje, Warsaw, Zagreb", "Central European Standard Time", []string{"Europe/Warsaw", "Europe/Sarajevo", "Europe/Skopje", "Europe/Zagreb"}},
+	{"(UTC+01:00) West Central Africa", "W. Central Africa Standard Time", []string{"Africa/Lagos", "Africa/Algiers", "Africa/Tunis", "Africa/Kinshasa", "Africa/Luanda", "Africa/Douala", "Africa/Ndjamena", "Africa/Niamey", "Africa/Porto-Novo", "Africa/Bangui", "Africa/Brazzaville", "Africa/Libreville", "Africa/Malabo", "Etc/GMT-1"}},
+	{"(UTC+02:00) Athens, Bucharest", "GTB Standard Time", []string{"Europe/Athens", "Europe/Bucharest", "Asia/Nicosia", "Asia/Famagusta"}},
+	{"(UTC+02:00) Beirut", "Middle East Standard Time", []string{"Asia/Beirut"}},
+	{"(UTC+02:00) Cairo", "Egypt Standard Time", []string{"Africa/Cairo"}},
+	{"(UTC+02:00) Chisinau", "E. Europe Standard Time", []string{"Europe/Chisinau"}},
+	{"(UTC+02:00) Gaza, Hebron", "West Bank Standard Time", []string{"Asia/Gaza", "Asia/Hebron"}},
+	{"(UTC+02:00) Harare, Pretoria", "South Africa Standard Time", []string{"Africa/Johannesburg", "Africa/Harare", "Africa/Maputo", "Africa/Lusaka", "Africa/Blantyre", "Africa/Gaborone", "Africa/Lubumbashi", "Africa/Bujumbura", "Africa/Kigali", "Africa/Maseru", "Africa/Mbabane", "Etc/GMT-2"}},
+	{"(UTC+02:00) Helsinki, Kyiv, Riga, Sofia, Tallinn, Vilnius", "FLE Standard Time", []string{"Europe/Kyiv", "Europe/Kiev", "Europe/Helsinki", "Europe/Riga", "Europe/Sofia", "Europe/Tallinn", "Europe/Vilnius", "Europe/Mariehamn"}},
+	{"(UTC+02:00) Jerusalem", "Israel Standard Time", []string{"Asia/Jerusalem", "Asia/Tel_Aviv"}},
+	{"(UTC+02:00) Juba", "South Sudan Standard Time", []string{"Africa/Juba"}},
+	{"(UTC+02:00) Kaliningrad", "Kaliningrad Standard Time", []string{"Europe/Kaliningrad"}},
+	{"(UTC+02:00) Khartoum", "Sudan Standard Time", []string{"Africa/Khartoum"}},
+	{"(UTC+02:00) Tripoli", "Libya Standard Time", []string{"Africa/Tripoli"}},
+	{"(UTC+02:00) Windhoek", "Namibia Standard Time", []string{"Africa/Windhoek"}},
+	{"(UTC+03:00) Amman", "Jordan Standard Time", []string{"Asia/Amman"}},
+	{"(UTC+03:00) Baghdad", "Arabic Standard Time", []string{"Asia/Baghdad"}},
+	{"(UTC+03:00) Damascus", "Syria Standard Time", []string{"Asia/Damascus"}},
+	{"(UTC+03:00) Istanbul", "Turkey Standard Time", []string{"Europe/Istanbul", "Asia/Istanbul"}},
+	{"(UTC+03:00) Kuwait, Riyadh", "Arab Standard Time", []string{"Asia/Riyadh", "Asia/Kuwait", "Asia/Qatar", "Asia/Bahrain", "Asia/Aden"}},
+	{"(UTC+03:00) Minsk", "Belarus Standard Time", []string{"Europe/Minsk"}},
+	{"(UTC+03:00) Moscow, St. Petersburg", "Russian Standard Time", []string{"Europe/Moscow", "Europe/Kirov", "Europe/Simferopol"}},
+	{"(UTC+03:00) Nairobi", "E. Africa Standard Time", []string{"Africa/Nairobi", "Africa/Addis_Ababa", "Africa/Asmara", "Africa/Dar_es_Salaam", "Africa/Djibouti", "Africa/Kampala", "Africa/Mogadishu", "Indian/Antananarivo", "Indian/Comoro", "Indian/Mayotte", "Etc/GMT-3"}},
+	{"(UTC+03:00) Volgograd", "Volgograd Standard Time", []string{"Europe/Volgograd"}},
+	{"(UTC+03:30) Tehran", "Iran Standard Time", []string{"Asia/Tehran"}},
+	{"(UTC+04:00) Abu Dhabi, Muscat", "Arabian Standard Time", []string{"Asia/Dubai", "Asia/Muscat", "Etc/GMT-4"}},
+	{"(UTC+04:00) Astrakhan, Ulyanovsk", "Astrakhan Standard Time", []string{"Europe/Astrakhan", "Europe/Ulyanovsk"}},
+	{"(UTC+04:00) Baku", "Azerbaijan Standard Time", []string{"Asia/Baku"}},
+	{"(UTC+04:00) Izhevsk, Samara", "Russia Time Zone 3", []string{"Europe/Samara"}},
+	{"(UTC+04:00) Port Louis", "Mauritius Standard Time", []string{"Indian/Mauritius", "Indian/Reunion", "Indian/Mahe"}},
+	{"(UTC+04:00) Saratov", "Saratov Standard Time", []string{"Europe/Saratov"}},
+	{"(UTC+04:00) Tbilisi", "Georgian Standard Time", []string{"Asia/Tbilisi"}},
+	{"(UTC+04:00) Yerevan", "Caucasus Standard Time", []string{"Asia/Yerevan"}},
+	{"(UTC+04:30) Kabul", "Afghanistan Standard Time", []string{"Asia/Kabul"}},
+	{"(UTC+05:00) Ashgabat, Tashkent", "West Asia Standard Time", []string{"Asia/Tashkent", "Asia/Ashgabat", "Asia/Samarkand", "Asia/Dushanbe", "Asia/Aqtobe", "Asia/Aqtau", "Asia/Atyrau", "Asia/Oral", "Indian/Maldives", "Indian/Kerguelen", "Etc/GMT-5"}},
+	{"(UTC+05:00) Ekaterinburg", "Ekaterinburg Standard Time", []string{"Asia/Yekaterinburg"}},
+	{"(UTC+05:00) Islamabad, Karachi", "Pakistan Standard Time", []string{"Asia/Karachi"}},
+	{"(UTC+05:00) Qyzylorda", "Qyzylorda Standard Time", []string{"Asia/Qyzylorda"}},
+	{"(UTC+05:30) Chennai, Kolkata, Mumbai, New Delhi", "India Standard Time", []string{"Asia/Kolkata", "Asia/Calcutta"}},
+	{"(UTC+05:30) Sri Jayawardenepura", "Sri Lanka Standard Time", []string{"Asia/Colombo"}},
+	{"(UTC+05:45) Kathmandu", "Nepal Standard Time", []string{"Asia/Kathmandu", "Asia/Katmandu"}},
+	{"(UTC+06:00) Astana", "Central Asia Standard Time", []string{"Asia/Almaty", "Asia/Bishkek", "Asia/Qostanay", "Asia/Urumqi", "Indian/Chagos", "Etc/GMT-6"}},
+	{"(UTC+06:00) Dhaka", "Bangladesh Standard Time", []string{"Asia/Dhaka", "Asia/Thimphu"}},
+	{"(UTC+06:00) Omsk", "Omsk Standard Time", []string{"Asia/Omsk"}},
+	{"(UTC+06:30) Yangon (Rangoon)", "Myanmar Standard Time", []string{"Asia/Yangon", "Asia/Rangoon", "Indian/Cocos"}},
+	{"(UTC+07:00) Bangkok, Hanoi, Jakarta", "SE Asia Standard Time", []string{"Asia/Bangkok", "Asia/Jakarta", "Asia/Ho_Chi_Minh", "Asia/Saigon", "Asia/Phnom_Penh", "Asia/Vientiane", "Asia/Pontianak", "Indian/Christmas", "Etc/GMT-7"}},
+	{"(UTC+07:00) Barnaul, Gorno-Altaysk", "Altai Standard Time", []string{"Asia/Barnaul"}},
+	{"(UTC+07:00) Hovd", "W. Mongolia Standard Time", []string{"Asia/Hovd"}},
+	{"(UTC+07:00) Krasnoyarsk", "North Asia Standard Time", []string{"Asia/Krasnoyarsk", "Asia/Novokuznetsk"}},
+	{"(UTC+07:00) Novosibirsk", "N. Central Asia Standard Time", []string{"Asia/Novosibirsk"}},
+	{"(UTC+07:00) Tomsk", "Tomsk Standard Time", []string{"Asia/Tomsk"}},
+	{"(UTC+08:00) Beijing, Chongqing, Hong Kong, Urumqi", "China Standard Time", []string{"Asia/Shanghai", "Asia/Hong_Kong", "Asia/Macau"}},
+	{"(UTC+08:00) Irkutsk", "North Asia East Standard Time", []string{"Asia/Irkutsk"}},
+	{"(UTC+08:00) Kuala Lumpur, Singapore", "Singapore Standard Time", []string{"Asia/Singapore", "Asia/Kuala_Lumpur", "Asia/Manila", "Asia/Makassar", "Asia/Brunei", "Asia/Kuching", "Etc/GMT-8"}},
+	{"(UTC+08:00) Perth", "W. Australia Standard Time", []string{"Australia/Perth"}},
+	{"(UTC+08:00) Taipei", "Taipei Standard Time", []string{"Asia/Taipei"}},
+	{"(UTC+08:00) Ulaanbaatar", "Ulaanbaatar Standard Time", []string{"Asia/Ulaanbaatar", "Asia/Choibalsan"}},
+	{"(UTC+08:45) Eucla", "Aus Central W. Standard Time", []string{"Australia/Eucla"}},
+	{"(UTC+09:00) Chita", "Transbaikal Standard Time", []string{"Asia/Chita"}},
+	{"(UTC+09:00) Osaka, Sapporo, Tokyo", "Tokyo Standard Time", []string{"Asia/Tokyo", "Asia/Jayapura", "Asia/Dili", "Pacific/Palau", "Etc/GMT-9"}},
+	{"(UTC+09:00) Pyongyang", "North Korea Standard Time", []string{"Asia/Pyongyang"}},
+	{"(UTC+09:00) Seoul", "Korea Standard Time", []string{"Asia/Seoul"}},
+	{"(UTC+09:00) Yakutsk", "Yakutsk Standard Time", []string{"Asia/Yakutsk", "Asia/Khandyga"}},
+	{"(UTC+09:30) Adelaide", "Cen. Australia Standard Time", []string{"Australia/Adelaide", "Australia/Broken_Hill"}},
+	{"(UTC+09:30) Darwin", "AUS Central Standard Time", []string{"Australia/Darwin"}},
+	{"(UTC+10:00) Brisbane", "E. Australia Standard Time", []string{"Australia/Brisbane", "Australia/Lindeman"}},
+	{"(UTC+10:00) Canberra, Melbourne, Sydney", "AUS Eastern Standard Time", []string{"Australia/Sydney", "Australia/Melbourne", "Australia/Canberra"}},
+	{"(UTC+10:00) Guam, Port Moresby", "West Pacific Standard Time", []string{"Pacific/Guam", "Pacific/Port_Moresby", "Pacific/Saipan", "Pacific/Chuuk", "Etc/GMT-10"}},
+	{"(UTC+10:00) Hobart", "Tasmania Standard Time", []string{"Australia/Hobart"}},
+	{"(UTC+10:00) Vladivostok", "Vladivostok Standard Time", []string{"Asia/Vladivostok", "Asia/Ust-Nera"}},
+	{"(UTC+10:30) Lord Howe Island", "Lord Howe Standard Time", []string{"Australia/Lord_Howe"}},
+	{"(UTC+11:00) Bougainville Island", "Bougainville Standard Time", []string{"Pacific/Bougainville"}},
+	{"(UTC+11:00) Chokurdakh", "Russia Time Zone 10", []string{"Asia/Srednekolymsk"}},
+	{"(UTC+11:00) Magadan", "Magadan Standard Time", []string{"Asia/Magadan"}},
+	{"(UTC+11:00) Norfolk Island", "Norfolk Standard Time", []string{"Pacific/Norfolk"}},
+	{"(UTC+11:00) Sakhalin", "Sakhalin Standard Time", []string{"Asia/Sakhalin"}},
+	{"(UTC+11:00) Solomon Is., New Caledonia", "Central Pacific Standard Time", []string{"Pacific/Guadalcanal", "Pacific/Noumea", "Pacific/Efate", "Pacific/Pohnpei", "Pacific/Kosrae", "Etc/GMT-11"}},
+	{"(UTC+12:00) Anadyr, Petropavlovsk-Kamchatsky", "Russia Time Zone 11", []string{"Asia/Kamchatka", "Asia/Anadyr"}},
+	{"(UTC+12:00) Auckland, Wellington", "New Zealand Standard Time", []string{"Pacific/Auckland", "Antarctica/McMurdo"}},
+	{"(UTC+12:00) Coordinated Universal Time+12", "UTC+12", []string{"Etc/GMT-12", "Pacific/Tarawa", "Pacific/Majuro", "Pacific/Kwajalein", "Pacific/Nauru", "Pacific/Funafuti", "Pacific/Wake", "Pacific/Wallis"}},
+	{"(UTC+12:00) Fiji", "Fiji Standard Time", []string{"Pacific/Fiji"}},
+	{"(UTC+12:45) Chatham Islands", "Chatham Islands Standard Time", []string{"Pacific/Chatham"}},
+	{"(UTC+13:00) Coordinated Universal Time+13", "UTC+13", []string{"Etc/GMT-13", "Pacific/Fakaofo", "Pacific/Kanton"}},
+	{"(UTC+13:00) Nuku'alofa", "Tonga Standard Time", []string{"Pacific/Tongatapu"}},
+	{"(UTC+13:00) Samoa", "Samoa Standard Time", []string{"Pacific/Apia"}},
+	{"(UTC+14:00) Kiritimati Island", "Line Islands Standard Time", []string{"Pacific/Kiritimati", "Etc/GMT-14"}},
+}
+
+// Locales supported by AlertOps
+var AlertOpsLocales = []string{
+	"ar-SA",
+	"da-DK",
+	"de-AT",
+	"de-CH",
+	"de-DE",
+	"en-AU",
+	"en-CA",
+	"en-GB",
+	"en-IE",
+	"en-IN",
+	"en-NZ",
+	"en-SG",
+	"en-US",
+	"en-ZA",
+	"es-AR",
+	"es-CO",
+	"es-ES",
+	"es-MX",
+	"fi-FI",
+	"fr-BE",
+	"fr-CA",
+	"fr-CH",
+	"fr-FR",
+	"he-IL",
+	"hi-IN",
+	"it-IT",
+	"ja-JP",
+	"ko-KR",
+	"nb-NO",
+	"nl-BE",
+	"nl-NL",
+	"pl-PL",
+	"pt-BR",
+	"pt-PT",
+	"ru-RU",
+	"sv-SE",
+	"tr-TR",
+	"zh-CN",
+	"zh-TW",
+}
+
+// lookupTimeZone finds a catalog entry by AlertOps name, Windows ID or IANA zone,
+// ignoring case
+func lookupTimeZone(value string) (*AlertOpsTimeZone, bool) {
+	value = strings.TrimSpace(value)
+	for i := range AlertOpsTimeZones {
+		tz := &AlertOpsTimeZones[i]
+		if strings.EqualFold(tz.Name, value) || strings.EqualFold(tz.WindowsID, value) || containsStringFold(tz.IANA, value) {
+			return tz, true
+		}
+	}
+	return nil, false
+}
+
+// alertOpsTimeZoneName returns the AlertOps name for a time zone, or the value
+// unchanged if it is not in the catalog
+func alertOpsTimeZoneName(value string) string {
+	if tz, ok := lookupTimeZone(value); ok {
+		return tz.Name
+	}
+	return value
+}
+
+// timeZoneLocation returns the location for a catalog time zone, using its first
+// IANA zone, or UTC if the time zone is unknown
+func timeZoneLocation(value string) *time.Location {
+	if tz, ok := lookupTimeZone(value); ok {
+		for _, zone := range tz.IANA {
+			if location, err := time.LoadLocation(zone); err == nil {
+				return location
+			}
+		}
+	}
+	return time.UTC
+}
+
+// lookupLocale finds a catalog locale, ignoring case and accepting "_" for "-"
+func lookupLocale(value string) (string, bool) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), "_", "-")
+	for _, locale := range AlertOpsLocales {
+		if strings.EqualFold(locale, value) {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+// alertOpsLocale returns the AlertOps form of a locale, or the value unchanged if
+// it is not in the catalog
+func alertOpsLocale(value string) string {
+	if locale, ok := lookupLocale(value); ok {
+		return locale
+	}
+	return value
+}
+
+// validateTimeZone checks a time zone against the catalog and suggests near misses
+func validateTimeZone(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if v == "" {
+		return
+	}
+	if _, ok := lookupTimeZone(v); ok {
+		return
+	}
+
+	candidates := make(map[string][]string, len(AlertOpsTimeZones))
+	for _, tz := range AlertOpsTimeZones {
+		labels := []string{tz.Name, tz.WindowsID}
+		if i := strings.Index(tz.Name, ") "); i >= 0 {
+			labels = append(labels, tz.Name[i+2:])
+		}
+		for _, zone := range tz.IANA {
+			labels = append(labels, zone, strings.ReplaceAll(zone[strings.LastIndex(zone, "/")+1:], "_", " "))
+		}
+		candidates[tz.Name] = labels
+	}
+
+	errs = append(errs, catalogError(key, v, "a time zone supported by AlertOps (an AlertOps name, Windows time zone ID or IANA zone)", suggestCatalogValues(v, candidates)))
+	return
+}
+
+// validateLocale checks a locale against the catalog and suggests near misses
+func validateLocale(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if v == "" {
+		return
+	}
+	if _, ok := lookupLocale(v); ok {
+		return
+	}
+
+	candidates := make(map[string][]string, len(AlertOpsLocales))
+	for _, locale := range AlertOpsLocales {
+		candidates[locale] = []string{locale}
+	}
+
+	errs = append(errs, catalogError(key, v, fmt.Sprintf("one of %v", AlertOpsLocales), suggestCatalogValues(v, candidates)))
+	return
+}
+
+// suppressEquivalentTimeZone suppresses diffs between aliases of the same time
+// zone, e.g. "America/New_York" and "(UTC-05:00) Eastern Time (US & Canada)"
+func suppressEquivalentTimeZone(k, old, new string, d *schema.ResourceData) bool {
+	oldTZ, oldOK := lookupTimeZone(old)
+	newTZ, newOK := lookupTimeZone(new)
+	return oldOK && newOK && oldTZ.Name == newTZ.Name
+}
+
+// suppressEquivalentLocale suppresses diffs such as "en_us" vs "en-US"
+func suppressEquivalentLocale(k, old, new string, d *schema.ResourceData) bool {
+	oldLocale, oldOK := lookupLocale(old)
+	newLocale, newOK := lookupLocale(new)
+	return oldOK && newOK && oldLocale == newLocale
+}
+
+// catalogError formats a validation error with suggestions
+func catalogError(key, value, expected string, suggestions []string) error {
+	if len(suggestions) == 0 {
+		return fmt.Errorf("%q: %q is not %s", key, value, expected)
+	}
+	return fmt.Errorf("%q: %q is not %s; did you mean %s?", key, value, expected, strings.Join(quoteStrings(suggestions), " or "))
+}
+
+// suggestCatalogValues returns up to three catalog values whose labels are close to
+// the input. Labels containing the input rank first, then by edit distance; only
+// the closest matches are kept.
+func suggestCatalogValues(input string, candidates map[string][]string) []string {
+	input = strings.ToLower(strings.TrimSpace(input))
+	maxDistance := len(input) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	type suggestion struct {
+		value     string
+		contained bool
+		distance  int
+	}
+	var suggestions []suggestion
+	for value, labels := range candidates {
+		best := suggestion{value: value, distance: -1}
+		for _, label := range labels {
+			label = strings.ToLower(label)
+			contained := len(input) >= 2 && strings.Contains(label, input)
+			distance := levenshteinDistance(input, label)
+			if best.distance < 0 || (contained && !best.contained) || (contained == best.contained && distance < best.distance) {
+				best.contained, best.distance = contained, distance
+			}
+		}
+		if best.contained || best.distance <= maxDistance {
+			suggestions = append(suggestions, best)
+		}
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].contained != suggestions[j].contained {
+			return suggestions[i].contained
+		}
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].value < suggestions[j].value
+	})
+
+	var result []string
+	for i := 0; i < len(suggestions) && i < 3; i++ {
+		if suggestions[i].contained != suggestions[0].contained || suggestions[i].distance > suggestions[0].distance+1 {
+			break
+		}
+		result = append(result, suggestions[i].value)
+	}
+	return result
+}
+
+// levenshteinDistance returns the edit distance between two strings
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(minInt(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// minInt returns the smaller of two ints
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// quoteStrings quotes each string for use in messages
+func quoteStrings(values []string) []string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = fmt.Sprintf("%q", v)
+	}
+	return result
+}
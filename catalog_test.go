@@ -0,0 +1,135 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAlertOpsTimeZoneName(t *testing.T) {
+	cases := map[string]string{
+		"(UTC-05:00) Eastern Time (US & Canada)": "(UTC-05:00) Eastern Time (US & Canada)",
+		"(utc-05:00) eastern time (us & canada)": "(UTC-05:00) Eastern Time (US & Canada)",
+		"Eastern Standard Time":                  "(UTC-05:00) Eastern Time (US & Canada)",
+		"America/New_York":                       "(UTC-05:00) Eastern Time (US & Canada)",
+		" america/los_angeles ":                  "(UTC-08:00) Pacific Time (US & Canada)",
+		"Pacific/Honolulu":                       "(UTC-10:00) Hawaii",
+		"Mars/Olympus_Mons":                      "Mars/Olympus_Mons",
+		"":                                       "",
+	}
+	for value, want := range cases {
+		if got := alertOpsTimeZoneName(value); got != want {
+			t.Errorf("alertOpsTimeZoneName(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestTimeZoneLocation(t *testing.T) {
+	cases := map[string]string{
+		"(UTC-05:00) Eastern Time (US & Canada)": "America/New_York",
+		"Hawaiian Standard Time":                 "Pacific/Honolulu",
+		"unknown":                                "UTC",
+	}
+	for value, want := range cases {
+		if got := timeZoneLocation(value).String(); got != want {
+			t.Errorf("timeZoneLocation(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestTimeZoneCatalog(t *testing.T) {
+	names := make(map[string]bool)
+	for _, tz := range AlertOpsTimeZones {
+		if names[tz.Name] {
+			t.Errorf("time zone %q is listed twice", tz.Name)
+		}
+		names[tz.Name] = true
+		if len(tz.IANA) == 0 {
+			t.Errorf("time zone %q has no IANA zone", tz.Name)
+			continue
+		}
+		// The first zone is the one schedule times are computed in
+		if _, err := time.LoadLocation(tz.IANA[0]); err != nil {
+			t.Errorf("time zone %q: %v", tz.Name, err)
+		}
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	cases := []struct {
+		value string
+		want  string
+		ok    bool
+	}{
+		{"en-US", "en-US", true},
+		{"en_us", "en-US", true},
+		{" PT-br ", "pt-BR", true},
+		{"en-XX", "", false},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		got, ok := lookupLocale(c.value)
+		if got != c.want || ok != c.ok {
+			t.Errorf("lookupLocale(%q) = %q, %v, want %q, %v", c.value, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestValidateTimeZoneSuggestions(t *testing.T) {
+	cases := []struct {
+		value   string
+		wantErr bool
+		suggest string
+	}{
+		{"America/New_York", false, ""},
+		{"", false, ""},
+		{"America/New_Yrok", true, "Eastern Time (US & Canada)"},
+		{"Honolulu", true, "Hawaii"},
+		{"zzzzzzzzzzzzzzzzzzzzzzzz", true, ""},
+	}
+	for _, c := range cases {
+		_, errs := validateTimeZone(c.value, "time_zone")
+		if (len(errs) > 0) != c.wantErr {
+			t.Errorf("validateTimeZone(%q) = %v, want error: %v", c.value, errs, c.wantErr)
+			continue
+		}
+		if len(errs) == 0 {
+			continue
+		}
+		message := errs[0].Error()
+		if c.suggest != "" && !strings.Contains(message, c.suggest) {
+			t.Errorf("validateTimeZone(%q) = %q, want a suggestion of %q", c.value, message, c.suggest)
+		}
+		if c.suggest == "" && strings.Contains(message, "did you mean") {
+			t.Errorf("validateTimeZone(%q) = %q, want no suggestion", c.value, message)
+		}
+	}
+}
+
+func TestValidateLocaleSuggestions(t *testing.T) {
+	_, errs := validateLocale("en-GX", "locale")
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), `"en-GB"`) {
+		t.Errorf("validateLocale(en-GX) = %v, want a suggestion of en-GB", errs)
+	}
+	if _, errs := validateLocale("en_gb", "locale"); len(errs) != 0 {
+		t.Errorf("validateLocale(en_gb) = %v, want no error", errs)
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"zürich", "zurich", 1},
+	}
+	for _, c := range cases {
+		if got := levenshteinDistance(c.a, c.b); got != c.want {
+			t.Errorf("levenshteinDistance(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
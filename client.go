@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -18,6 +20,31 @@ type Client struct {
 	apiKey     string
 	baseURL    string
 	httpClient *retryablehttp.Client
+
+	// cache holds GET response bodies for lookups made during plan
+	cacheMu sync.Mutex
+	cache   map[string][]byte
+
+	// locks serializes read-modify-write updates to a single object
+	locksMu sync.Mutex
+	locks   map[string]*sync.Mutex
+
+	// plannedNames holds the user and group names planned in this run, keyed by
+	// member type and lower-case name, so members created in the same run pass
+	// the plan-time existence check
+	plannedNamesMu sync.Mutex
+	plannedNames   map[string]bool
+
+	// plannedGroups holds the nested groups of each alertops_group planned so far
+	// in this run, keyed by lower-case group name, for cycle detection across
+	// resources. Groups planned later in the run aren't in it yet.
+	plannedGroupsMu sync.Mutex
+	plannedGroups   map[string][]string
+
+	// warnOnScheduleGaps warns after applying schedules that leave their group
+	// without coverage during the next scheduleGapCheckDays days
+	warnOnScheduleGaps   bool
+	scheduleGapCheckDays int
 }
 
 func NewClient(apiKey, baseURL string) *Client {
@@ -30,6 +57,7 @@ func NewClient(apiKey, baseURL string) *Client {
 		apiKey:     apiKey,
 		baseURL:    strings.TrimRight(baseURL, "/"),
 		httpClient: retryClient,
+		cache:      make(map[string][]byte),
 	}
 }
 
@@ -102,7 +130,7 @@ func (c *Client) get(ctx context.Context, path string, result interface{}) error
 		bodyBytes, _ := io.ReadAll(resp.Body)
 		fullURL := fmt.Sprintf("%s%s", c.baseURL, path)
 		
-		return fmt.Errorf("GET request failed:\n"+
+		return &APIError{StatusCode: resp.StatusCode, Message: fmt.Sprintf("GET request failed:\n"+
 			"━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n"+
 			"URL: %s\n"+
 			"METHOD: GET\n"+
@@ -113,12 +141,119 @@ func (c *Client) get(ctx context.Context, path string, result interface{}) error
 			"RESPONSE STATUS: %d\n"+
 			"RESPONSE BODY:\n%s\n"+
 			"━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━", 
-			fullURL, c.apiKey, resp.StatusCode, string(bodyBytes))
+			fullURL, c.apiKey, resp.StatusCode, string(bodyBytes))}
 	}
 
 	return json.NewDecoder(resp.Body).Decode(result)
 }
 
+// APIError is returned by the request methods when AlertOps responds with an
+// unexpected status
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+// isHTTPStatus reports whether err is from a request that failed with one of
+// the given response statuses
+func isHTTPStatus(err error, statuses ...int) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, status := range statuses {
+		if apiErr.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+// getCached performs a GET request once per provider run and decodes the cached
+// response on subsequent calls. Use it for catalog lookups during plan, not for
+// reading managed resources.
+func (c *Client) getCached(ctx context.Context, path string, result interface{}) error {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	if body, ok := c.cache[path]; ok {
+		return json.Unmarshal(body, result)
+	}
+
+	var raw json.RawMessage
+	if err := c.get(ctx, path, &raw); err != nil {
+		return err
+	}
+	c.cache[path] = raw
+
+	return json.Unmarshal(raw, result)
+}
+
+// lock acquires the lock for key, e.g. a group path, and returns a function that
+// releases it. Resources that update part of a shared object hold it around the
+// GET and PUT so that concurrent applies don't overwrite each other.
+func (c *Client) lock(key string) func() {
+	c.locksMu.Lock()
+	if c.locks == nil {
+		c.locks = make(map[string]*sync.Mutex)
+	}
+	mu, ok := c.locks[key]
+	if !ok {
+		mu = &sync.Mutex{}
+		c.locks[key] = mu
+	}
+	c.locksMu.Unlock()
+
+	mu.Lock()
+	return mu.Unlock
+}
+
+// addPlannedName records a user or group name planned in this run. The names only
+// cover resources planned so far by this provider process, so they can quiet
+// warnings but must not decide whether a plan fails.
+func (c *Client) addPlannedName(memberType, name string) {
+	c.plannedNamesMu.Lock()
+	defer c.plannedNamesMu.Unlock()
+
+	if c.plannedNames == nil {
+		c.plannedNames = make(map[string]bool)
+	}
+	c.plannedNames[memberType+"/"+strings.ToLower(name)] = true
+}
+
+// isPlannedName reports whether a user or group name has been planned in this run
+func (c *Client) isPlannedName(memberType, name string) bool {
+	c.plannedNamesMu.Lock()
+	defer c.plannedNamesMu.Unlock()
+
+	return c.plannedNames[memberType+"/"+strings.ToLower(name)]
+}
+
+// setPlannedNestedGroups records the nested groups planned for a group
+func (c *Client) setPlannedNestedGroups(groupName string, nestedGroups []string) {
+	c.plannedGroupsMu.Lock()
+	defer c.plannedGroupsMu.Unlock()
+
+	if c.plannedGroups == nil {
+		c.plannedGroups = make(map[string][]string)
+	}
+	c.plannedGroups[strings.ToLower(groupName)] = nestedGroups
+}
+
+// plannedNestedGroups returns the nested groups planned for a group, if it has
+// been planned in this run
+func (c *Client) plannedNestedGroups(groupName string) ([]string, bool) {
+	c.plannedGroupsMu.Lock()
+	defer c.plannedGroupsMu.Unlock()
+
+	nestedGroups, ok := c.plannedGroups[strings.ToLower(groupName)]
+	return nestedGroups, ok
+}
+
 func (c *Client) post(ctx context.Context, path string, body, result interface{}) error {
 	resp, err := c.doRequest(ctx, "POST", path, body)
 	if err != nil {
@@ -140,7 +275,7 @@ func (c *Client) post(ctx context.Context, path string, body, result interface{}
 		
 		fullURL := fmt.Sprintf("%s%s", c.baseURL, path)
 		
-		return fmt.Errorf("🔥🔥🔥 API CALL DEBUG - FIXED AUTHENTICATION 🔥🔥🔥\n"+
+		return &APIError{StatusCode: resp.StatusCode, Message: fmt.Sprintf("🔥🔥🔥 API CALL DEBUG - FIXED AUTHENTICATION 🔥🔥🔥\n"+
 			"URL: %s\n"+
 			"METHOD: POST\n"+
 			"HEADERS:\n"+
@@ -150,7 +285,7 @@ func (c *Client) post(ctx context.Context, path string, body, result interface{}
 			"REQUEST BODY:\n%s\n"+
 			"RESPONSE STATUS: %d\n"+
 			"RESPONSE BODY:\n%s", 
-			fullURL, c.apiKey, requestJSON, resp.StatusCode, string(bodyBytes))
+			fullURL, c.apiKey, requestJSON, resp.StatusCode, string(bodyBytes))}
 	}
 
 	if result != nil {
@@ -181,7 +316,7 @@ func (c *Client) put(ctx context.Context, path string, body, result interface{})
 		
 		fullURL := fmt.Sprintf("%s%s", c.baseURL, path)
 		
-		return fmt.Errorf("PUT request failed:\n"+
+		return &APIError{StatusCode: resp.StatusCode, Message: fmt.Sprintf("PUT request failed:\n"+
 			"━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n"+
 			"URL: %s\n"+
 			"METHOD: PUT\n"+
@@ -194,7 +329,7 @@ func (c *Client) put(ctx context.Context, path string, body, result interface{})
 			"RESPONSE STATUS: %d\n"+
 			"RESPONSE BODY:\n%s\n"+
 			"━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━", 
-			fullURL, c.apiKey, requestJSON, resp.StatusCode, string(bodyBytes))
+			fullURL, c.apiKey, requestJSON, resp.StatusCode, string(bodyBytes))}
 	}
 
 	if result != nil {
@@ -216,7 +351,7 @@ func (c *Client) delete(ctx context.Context, path string) error {
 		bodyBytes, _ := io.ReadAll(resp.Body)
 		fullURL := fmt.Sprintf("%s%s", c.baseURL, path)
 		
-		return fmt.Errorf("DELETE request failed:\n"+
+		return &APIError{StatusCode: resp.StatusCode, Message: fmt.Sprintf("DELETE request failed:\n"+
 			"━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n"+
 			"URL: %s\n"+
 			"METHOD: DELETE\n"+
@@ -227,7 +362,7 @@ func (c *Client) delete(ctx context.Context, path string) error {
 			"RESPONSE STATUS: %d\n"+
 			"RESPONSE BODY:\n%s\n"+
 			"━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━", 
-			fullURL, c.apiKey, resp.StatusCode, string(bodyBytes))
+			fullURL, c.apiKey, resp.StatusCode, string(bodyBytes))}
 	}
 
 	return nil
@@ -0,0 +1,404 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceGroup reads a single group by ID or name
+func dataSourceGroup() *schema.Resource {
+	groupSchema := getGroupDataSourceSchema()
+	groupSchema["group_id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "ID of the group",
+		ExactlyOneOf: []string{"group_id", "group_name"},
+	}
+	groupSchema["group_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Name of the group, compared case-insensitively",
+		ExactlyOneOf: []string{"group_id", "group_name"},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceGroupRead,
+		Schema:      groupSchema,
+	}
+}
+
+func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupID, ok := d.GetOk("group_id")
+	if !ok {
+		found, err := findGroupByName(ctx, client, d.Get("group_name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if found == nil {
+			return diag.Errorf("no group found with group_name %q", d.Get("group_name").(string))
+		}
+		groupID = found.GroupID
+	}
+
+	var group Group
+	err := client.get(ctx, fmt.Sprintf("/api/v2/groups/%d", groupID.(int)), &group)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read group: %w", err))
+	}
+
+	d.SetId(strconv.Itoa(group.GroupID))
+	for key, value := range flattenGroupData(group) {
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+// dataSourceGroups lists groups, optionally filtered
+func dataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return groups whose name matches this regular expression",
+				ValidateFunc: validateRegexp,
+			},
+			"topic": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return groups with this topic",
+			},
+			"attribute": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only return groups with this attribute. All attribute filters must match",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the attribute",
+						},
+						"attribute_value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value of the attribute. If omitted, any value matches",
+						},
+					},
+				},
+			},
+			"dynamic": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return dynamic (true) or static (false) groups",
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching groups, in the order AlertOps lists them",
+				Elem: &schema.Resource{
+					Schema: getGroupDataSourceSchema(),
+				},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the matching groups",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the matching groups",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groups, err := listGroups(ctx, client, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		// Values known only at apply time skip ValidateFunc, so compile errors surface here
+		var err error
+		nameRegex, err = regexp.Compile(v.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("name_regex: %w", err))
+		}
+	}
+	topic := d.Get("topic").(string)
+	attributes := expandGroupAttributes(d.Get("attribute").([]interface{}))
+
+	// dynamic filters only when set; false is a valid filter value
+	dynamicFilter := "any"
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		if v := raw.GetAttr("dynamic"); v.IsKnown() && !v.IsNull() {
+			dynamicFilter = strconv.FormatBool(v.True())
+		}
+	}
+
+	var matched []interface{}
+	var ids []int
+	var names []string
+	for _, group := range groups {
+		if nameRegex != nil && !nameRegex.MatchString(group.GroupName) {
+			continue
+		}
+		if topic != "" && !containsString(group.Topics, topic) {
+			continue
+		}
+		if dynamicFilter != "any" && strconv.FormatBool(group.Dynamic) != dynamicFilter {
+			continue
+		}
+		if !groupHasAttributes(group, attributes) {
+			continue
+		}
+
+		matched = append(matched, flattenGroupData(group))
+		ids = append(ids, group.GroupID)
+		names = append(names, group.GroupName)
+	}
+
+	// The ID identifies the filters, not the results
+	filters := fmt.Sprintf("%s|%s|%v|%s", d.Get("name_regex").(string), topic, attributes, dynamicFilter)
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(filters))))
+	d.Set("groups", matched)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return nil
+}
+
+// groupHasAttributes reports whether a group has every attribute; an empty
+// attribute value matches any value
+func groupHasAttributes(group Group, attributes []GroupAttribute) bool {
+	for _, want := range attributes {
+		found := false
+		for _, have := range group.Attributes {
+			if strings.EqualFold(have.AttributeName, want.AttributeName) && (want.AttributeValue == "" || have.AttributeValue == want.AttributeValue) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// flattenGroupData converts a Group struct to Terraform data for the group data
+// sources, as read by the alertops_group resource
+func flattenGroupData(group Group) map[string]interface{} {
+	return map[string]interface{}{
+		"group_id":        group.GroupID,
+		"group_name":      group.GroupName,
+		"dynamic":         group.Dynamic,
+		"description":     group.Description,
+		"members":         flattenGroupMembers(group.Members),
+		"contact_methods": flattenGroupContactMethods(group.ContactMethods),
+		"topics":          group.Topics,
+		"attributes":      flattenGroupAttributes(group.Attributes),
+		"dynamic_rules":   flattenGroupDynamicRules(group.DynamicRules),
+	}
+}
+
+// Helper function to get group data source schema
+func getGroupDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"group_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "ID of the group",
+		},
+		"group_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the group",
+		},
+		"dynamic": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the group is dynamic",
+		},
+		"description": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Description lines",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"members": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Group members (users or other groups)",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"member_type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Type of member: 'User' or 'Group'",
+					},
+					"member": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Username or group name",
+					},
+					"sequence": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Sequence order",
+					},
+					"roles": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "Roles for this member",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"contact_methods": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Contact methods for the group",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"contact_method_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the contact method",
+					},
+					"email_address": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Email address",
+					},
+					"country_code": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Country calling code",
+					},
+					"phone_number": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Phone number",
+					},
+					"e164": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Phone number in E.164 format",
+					},
+					"extension": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Phone extension",
+					},
+					"url": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "URL",
+					},
+					"get_alert_update": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether to get alert updates",
+					},
+					"enabled": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the contact method is enabled",
+					},
+					"sequence": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Sequence order",
+					},
+				},
+			},
+		},
+		"topics": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Topics associated with the group",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"attributes": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Custom attributes for the group",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"attribute_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the attribute",
+					},
+					"attribute_value": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Value of the attribute",
+					},
+				},
+			},
+		},
+		"dynamic_rules": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Rules that decide the members of a dynamic group",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rule_type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "What the rule tests: 'user_attribute', 'role' or 'time_zone'",
+					},
+					"attribute_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "User attribute tested by user_attribute rules",
+					},
+					"operator": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Comparison operator",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Value compared with",
+					},
+				},
+			},
+		},
+	}
+}
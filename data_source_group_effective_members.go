@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceGroupEffectiveMembers lists the users paged through a group, with
+// nested groups expanded
+func dataSourceGroupEffectiveMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupEffectiveMembersRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "ID of the group",
+				ExactlyOneOf: []string{"group_id", "group_name"},
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Name of the group",
+				ExactlyOneOf: []string{"group_id", "group_name"},
+			},
+			"max_depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultGroupNestingDepth,
+				Description:  "How many levels of nested groups to expand; 0 lists only direct user members",
+				ValidateFunc: validateIntBetween(0, 100),
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Users paged through the group, each listed once through the shortest path",
+				Elem:        getEffectiveMemberSchema(),
+			},
+			"user_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "User names of members",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether groups nested deeper than max_depth were skipped",
+			},
+		},
+	}
+}
+
+func dataSourceGroupEffectiveMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	var group Group
+	if groupID, ok := d.GetOk("group_id"); ok {
+		err := client.get(ctx, fmt.Sprintf("/api/v2/groups/%d", groupID.(int)), &group)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to read group: %w", err))
+		}
+	} else {
+		found, err := findGroupByName(ctx, client, d.Get("group_name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if found == nil {
+			return diag.Errorf("no group found with group_name %q", d.Get("group_name").(string))
+		}
+
+		err = client.get(ctx, fmt.Sprintf("/api/v2/groups/%d", found.GroupID), &group)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to read group: %w", err))
+		}
+	}
+
+	members, truncated, err := expandEffectiveMembers(ctx, newGroupMembershipLoader(client, false), group.GroupName, group.Members, d.Get("max_depth").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userNames := make([]string, len(members))
+	for i, member := range members {
+		userNames[i] = member.UserName
+	}
+
+	d.SetId(strconv.Itoa(group.GroupID))
+	d.Set("group_id", group.GroupID)
+	d.Set("group_name", group.GroupName)
+	d.Set("members", flattenEffectiveMembers(members))
+	d.Set("user_names", userNames)
+	d.Set("truncated", truncated)
+
+	return nil
+}
+
+// Helper function to get effective member schema
+func getEffectiveMemberSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User name",
+			},
+			"via": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Nested groups the user is paged through, outermost first; empty for direct members",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Nesting depth, 0 for direct members",
+			},
+		},
+	}
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Valid ways of combining group selection conditions
+var GroupSelectionMatchModes = []string{
+	"all",
+	"any",
+}
+
+// groupSelectionCondition is one topic or attribute condition of a group selection
+type groupSelectionCondition struct {
+	Topic          string
+	TopicRegex     *regexp.Regexp
+	AttributeName  string
+	AttributeValue string
+	ValueRegex     *regexp.Regexp
+}
+
+// dataSourceGroupSelection selects groups by topic and attribute conditions so that
+// routing can target groups by metadata rather than by name
+func dataSourceGroupSelection() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupSelectionRead,
+		Schema: map[string]*schema.Schema{
+			"match": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "all",
+				Description: "Whether a group must match all conditions (AND) or any condition (OR)",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					for _, mode := range GroupSelectionMatchModes {
+						if v == mode {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be one of %v, got: %q", key, GroupSelectionMatchModes, v))
+					return
+				},
+			},
+			"condition": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Topic or attribute conditions. Set exactly one of topic, topic_regex or attribute_name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Group has this topic (case-insensitive)",
+						},
+						"topic_regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Group has a topic matching this regular expression",
+							ValidateFunc: validateRegexp,
+						},
+						"attribute_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Group has this attribute (case-insensitive). Without a value condition any value matches",
+						},
+						"attribute_value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The attribute has exactly this value",
+						},
+						"attribute_value_regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The attribute has a value matching this regular expression",
+							ValidateFunc: validateRegexp,
+						},
+					},
+				},
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Selected groups, ordered by name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "ID of the group",
+						},
+						"group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the group",
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the selected groups, ordered by name, for recipient_groups and workflow groups",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the selected groups, in the same order as names",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupSelectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	conditions, err := expandGroupSelectionConditions(d.Get("condition").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	matchAll := d.Get("match").(string) == "all"
+
+	groups, err := listGroups(ctx, client, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var selected []Group
+	for _, group := range groups {
+		if groupMatchesSelection(group, conditions, matchAll) {
+			selected = append(selected, group)
+		}
+	}
+
+	// Order by name, then ID, so that plans don't change with the API's order
+	sort.SliceStable(selected, func(i, j int) bool {
+		a, b := strings.ToLower(selected[i].GroupName), strings.ToLower(selected[j].GroupName)
+		if a != b {
+			return a < b
+		}
+		return selected[i].GroupID < selected[j].GroupID
+	})
+
+	result := make([]interface{}, len(selected))
+	names := make([]string, len(selected))
+	ids := make([]int, len(selected))
+	for i, group := range selected {
+		result[i] = map[string]interface{}{
+			"group_id":   group.GroupID,
+			"group_name": group.GroupName,
+		}
+		names[i] = group.GroupName
+		ids[i] = group.GroupID
+	}
+
+	// The ID identifies the selection, not the results
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprintf("%s|%v", d.Get("match").(string), d.Get("condition"))))))
+	d.Set("groups", result)
+	d.Set("names", names)
+	d.Set("ids", ids)
+
+	return nil
+}
+
+// expandGroupSelectionConditions converts Terraform data to groupSelectionCondition
+// structs, checking that each condition tests exactly one thing
+func expandGroupSelectionConditions(conditionsData []interface{}) ([]groupSelectionCondition, error) {
+	conditions := make([]groupSelectionCondition, 0, len(conditionsData))
+	for i, conditionData := range conditionsData {
+		if conditionData == nil {
+			return nil, fmt.Errorf("condition.%d: set one of topic, topic_regex or attribute_name", i)
+		}
+		c := conditionData.(map[string]interface{})
+
+		condition := groupSelectionCondition{
+			Topic:          c["topic"].(string),
+			AttributeName:  c["attribute_name"].(string),
+			AttributeValue: c["attribute_value"].(string),
+		}
+		// Values known only at apply time skip ValidateFunc, so compile errors surface here
+		if v := c["topic_regex"].(string); v != "" {
+			re, err := regexp.Compile(v)
+			if err != nil {
+				return nil, fmt.Errorf("condition.%d.topic_regex: %w", i, err)
+			}
+			condition.TopicRegex = re
+		}
+		if v := c["attribute_value_regex"].(string); v != "" {
+			re, err := regexp.Compile(v)
+			if err != nil {
+				return nil, fmt.Errorf("condition.%d.attribute_value_regex: %w", i, err)
+			}
+			condition.ValueRegex = re
+		}
+
+		set := 0
+		for _, ok := range []bool{condition.Topic != "", condition.TopicRegex != nil, condition.AttributeName != ""} {
+			if ok {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("condition.%d: set exactly one of topic, topic_regex or attribute_name", i)
+		}
+		if condition.AttributeName == "" && (condition.AttributeValue != "" || condition.ValueRegex != nil) {
+			return nil, fmt.Errorf("condition.%d: attribute_value and attribute_value_regex require attribute_name", i)
+		}
+		if condition.AttributeValue != "" && condition.ValueRegex != nil {
+			return nil, fmt.Errorf("condition.%d: set only one of attribute_value and attribute_value_regex", i)
+		}
+
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+// groupMatchesSelection reports whether a group matches all (or, if matchAll is
+// false, any) of the conditions
+func groupMatchesSelection(group Group, conditions []groupSelectionCondition, matchAll bool) bool {
+	for _, condition := range conditions {
+		if condition.matches(group) != matchAll {
+			return !matchAll
+		}
+	}
+	return matchAll
+}
+
+// matches reports whether a group satisfies the condition
+func (c groupSelectionCondition) matches(group Group) bool {
+	switch {
+	case c.Topic != "":
+		return containsStringFold(group.Topics, c.Topic)
+	case c.TopicRegex != nil:
+		for _, topic := range group.Topics {
+			if c.TopicRegex.MatchString(topic) {
+				return true
+			}
+		}
+		return false
+	default:
+		for _, attribute := range group.Attributes {
+			if !strings.EqualFold(attribute.AttributeName, c.AttributeName) {
+				continue
+			}
+			switch {
+			case c.ValueRegex != nil:
+				if c.ValueRegex.MatchString(attribute.AttributeValue) {
+					return true
+				}
+			case c.AttributeValue != "":
+				if attribute.AttributeValue == c.AttributeValue {
+					return true
+				}
+			default:
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// validateRegexp checks that a string is a valid regular expression
+func validateRegexp(val interface{}, key string) (warns []string, errs []error) {
+	if _, err := regexp.Compile(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid regular expression: %v", key, err))
+	}
+	return
+}
@@ -0,0 +1,577 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceInboundMappingTest evaluates an inbound integration mapping against a
+// sample payload locally, without sending anything to AlertOps
+func dataSourceInboundMappingTest() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInboundMappingTestRead,
+		Schema: map[string]*schema.Schema{
+			"inbound_integration_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Description:   "Existing inbound integration whose API mapping should be tested",
+				ConflictsWith: []string{"url_mapping", "alert_tags", "filters_to_match_json_or_form_fields"},
+			},
+			"url_mapping": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "URL mapping configuration to test, as in api_settings",
+				Elem:        getURLMappingSchema(),
+			},
+			"alert_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Alert tags configuration to test, as in api_settings",
+				Elem:        getAlertTagsSchema(),
+			},
+			"filters_to_match_json_or_form_fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: fmt.Sprintf("Filters to test, as in api_settings. Filter conditions have no values, so only these types can be evaluated: %v", InboundFilterConditionTypes),
+				Elem:        getFiltersSchema(),
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Sample payload as it would be posted to the integration",
+			},
+			"payload_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "json",
+				Description: "Format of the sample payload (json, form)",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					validFormats := []string{"json", "form"}
+					for _, validFormat := range validFormats {
+						if v == validFormat {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be one of %v, got: %q", key, validFormats, v))
+					return
+				},
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "What the payload would do: open, update, close, none (no condition matched) or filtered (rejected by filters)",
+			},
+			"filters_passed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the payload passed the configured filters",
+			},
+			"filter_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Result of each configured filter",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter_set": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Filter set the filter belongs to (add_all_filter, add_any_filter)",
+						},
+						"field_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Field the filter was evaluated against",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Filter condition type",
+						},
+						"not": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the filter is negated",
+						},
+						"matched": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the filter matched",
+						},
+					},
+				},
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted source",
+			},
+			"source_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted source name",
+			},
+			"source_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted source ID",
+			},
+			"source_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted source URL",
+			},
+			"source_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted source status",
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted severity",
+			},
+			"assignee": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted assignee",
+			},
+			"short_text": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted short text",
+			},
+			"long_text": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted long text",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted subject",
+			},
+			"recipient_user": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted recipient user",
+			},
+			"recipient_group": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted recipient group",
+			},
+			"topic": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Extracted topic",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Extracted alert tags keyed by tag name",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"custom_fields": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Extracted custom alert fields keyed by attribute name",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"missing_required_fields": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Required custom alert fields not found in the payload",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInboundMappingTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var urlMapping *InboundIntegrationURLMapping
+	var alertTags *InboundIntegrationAlertTags
+	var filters *InboundIntegrationFilters
+
+	// If inbound_integration_id is provided, test the mapping stored in AlertOps
+	if id, ok := d.GetOk("inbound_integration_id"); ok {
+		client := meta.(*Client)
+
+		var inboundIntegration InboundIntegration
+		err := client.get(ctx, fmt.Sprintf("/api/v2/integrations/inbound/%d", id.(int)), &inboundIntegration)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading inbound integration: %v", err))
+		}
+		if inboundIntegration.APISettings == nil {
+			return diag.Errorf("inbound integration %d has no api_settings to test", id.(int))
+		}
+
+		urlMapping = inboundIntegration.APISettings.URLMapping
+		alertTags = inboundIntegration.APISettings.AlertTags
+		filters = inboundIntegration.APISettings.FiltersToMatchJSONOrFormFields
+	} else {
+		urlMapping = expandURLMapping(d.Get("url_mapping").([]interface{}))
+		alertTags = expandAlertTags(d.Get("alert_tags").([]interface{}))
+		filters = expandFilters(d.Get("filters_to_match_json_or_form_fields").([]interface{}))
+	}
+
+	payloadText := d.Get("payload").(string)
+	payload, err := parseInboundPayload(payloadText, d.Get("payload_format").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, err := evaluateInboundMapping(payload, urlMapping, alertTags, filters)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The ID identifies the inputs, so that a mapping change with the same payload
+	// is a new result
+	mappingJSON, _ := json.Marshal([]interface{}{urlMapping, alertTags, filters})
+	inputs := fmt.Sprintf("%s|%s|%s", mappingJSON, d.Get("payload_format").(string), payloadText)
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(inputs))))
+	d.Set("action", result.Action)
+	d.Set("filters_passed", result.FiltersPassed)
+	d.Set("filter_results", result.FilterResults)
+	for field, value := range result.Fields {
+		d.Set(field, value)
+	}
+	d.Set("tags", result.Tags)
+	d.Set("custom_fields", result.CustomFields)
+	d.Set("missing_required_fields", result.MissingRequiredFields)
+
+	return nil
+}
+
+// inboundMappingResult is the outcome of evaluating a mapping against a payload
+type inboundMappingResult struct {
+	Action                string
+	FiltersPassed         bool
+	FilterResults         []map[string]interface{}
+	Fields                map[string]string
+	Tags                  map[string]string
+	CustomFields          map[string]string
+	MissingRequiredFields []string
+}
+
+// parseInboundPayload decodes a sample payload into generic JSON values. Form
+// payloads are decoded into a flat object keyed by field name.
+func parseInboundPayload(payload, format string) (interface{}, error) {
+	if format == "form" {
+		values, err := url.ParseQuery(payload)
+		if err != nil {
+			return nil, fmt.Errorf("payload is not valid form data: %w", err)
+		}
+		result := make(map[string]interface{}, len(values))
+		for k, v := range values {
+			result[k] = strings.Join(v, ",")
+		}
+		return result, nil
+	}
+
+	var result interface{}
+	if err := json.Unmarshal([]byte(payload), &result); err != nil {
+		return nil, fmt.Errorf("payload is not valid JSON: %w", err)
+	}
+	return result, nil
+}
+
+// evaluateInboundMapping applies filters, open/update/close conditions and field
+// extraction to a payload the way AlertOps processes an inbound API request
+func evaluateInboundMapping(payload interface{}, urlMapping *InboundIntegrationURLMapping, alertTags *InboundIntegrationAlertTags, filters *InboundIntegrationFilters) (*inboundMappingResult, error) {
+	if urlMapping == nil {
+		urlMapping = &InboundIntegrationURLMapping{}
+	}
+
+	result := &inboundMappingResult{
+		FiltersPassed: true,
+		FilterResults: []map[string]interface{}{},
+		Fields:        map[string]string{},
+		Tags:          map[string]string{},
+		CustomFields:  map[string]string{},
+	}
+
+	// Filters
+	if filters != nil {
+		if filters.AddAllFilter != nil {
+			for _, filter := range filters.AddAllFilter.Filters {
+				matched, err := evaluateInboundFilter(payload, filter)
+				if err != nil {
+					return nil, err
+				}
+				result.FilterResults = append(result.FilterResults, flattenInboundFilterResult("add_all_filter", filter, matched))
+				if !matched {
+					result.FiltersPassed = false
+				}
+			}
+		}
+		if filters.AddAnyFilter != nil && len(filters.AddAnyFilter.Filters) > 0 {
+			anyMatched := false
+			for _, filter := range filters.AddAnyFilter.Filters {
+				matched, err := evaluateInboundFilter(payload, filter)
+				if err != nil {
+					return nil, err
+				}
+				result.FilterResults = append(result.FilterResults, flattenInboundFilterResult("add_any_filter", filter, matched))
+				anyMatched = anyMatched || matched
+			}
+			if !anyMatched {
+				result.FiltersPassed = false
+			}
+		}
+	}
+
+	// Field extraction
+	if urlMapping.Static {
+		result.Fields["source"] = urlMapping.SourceValue
+	} else {
+		result.Fields["source"], _ = lookupPayloadField(payload, urlMapping.Source)
+	}
+	fieldPaths := map[string]string{
+		"source_name":     urlMapping.SourceName,
+		"source_id":       urlMapping.SourceID,
+		"source_url":      urlMapping.SourceURL,
+		"source_status":   urlMapping.SourceStatus,
+		"severity":        urlMapping.Severity,
+		"assignee":        urlMapping.Assignee,
+		"short_text":      urlMapping.ShortText,
+		"long_text":       urlMapping.LongText,
+		"subject":         urlMapping.Subject,
+		"recipient_user":  urlMapping.RecipientUser,
+		"recipient_group": urlMapping.RecipientGroup,
+		"topic":           urlMapping.Topic,
+	}
+	for field, path := range fieldPaths {
+		result.Fields[field], _ = lookupPayloadField(payload, path)
+	}
+
+	if alertTags != nil {
+		tagPaths := map[string]string{
+			"business_service": alertTags.BusinessService,
+			"component_type":   alertTags.ComponentType,
+			"component_name":   alertTags.ComponentName,
+			"data_center":      alertTags.DataCenter,
+			"environment":      alertTags.Environment,
+			"problem_type":     alertTags.ProblemType,
+		}
+		for tag, path := range tagPaths {
+			if value, found := lookupPayloadField(payload, path); found {
+				result.Tags[tag] = value
+			}
+		}
+	}
+
+	for _, field := range urlMapping.CustomAlertFields {
+		value, found := lookupPayloadField(payload, field.AttributeValue)
+		if found {
+			result.CustomFields[field.AttributeName] = value
+		} else if field.Required {
+			result.MissingRequiredFields = append(result.MissingRequiredFields, field.AttributeName)
+		}
+	}
+	sort.Strings(result.MissingRequiredFields)
+
+	// Alert action. Close and update conditions are evaluated against the same
+	// status field as the open condition.
+	if !result.FiltersPassed {
+		result.Action = "filtered"
+		return result, nil
+	}
+
+	statusField := urlMapping.SourceStatus
+	if urlMapping.OpenAlertWhen != nil && urlMapping.OpenAlertWhen.FieldName != "" {
+		statusField = urlMapping.OpenAlertWhen.FieldName
+	}
+	status, statusFound := lookupPayloadField(payload, statusField)
+
+	if urlMapping.CloseAlertWhen != nil {
+		matched, err := matchInboundCondition(urlMapping.CloseAlertWhen.Type, status, statusFound, urlMapping.CloseAlertWhen.Values)
+		if err != nil {
+			return nil, fmt.Errorf("close_alert_when: %w", err)
+		}
+		if matched {
+			result.Action = "close"
+			return result, nil
+		}
+	}
+	if urlMapping.UpdateAlertWhen != nil {
+		matched, err := matchInboundCondition(urlMapping.UpdateAlertWhen.Type, status, statusFound, urlMapping.UpdateAlertWhen.Values)
+		if err != nil {
+			return nil, fmt.Errorf("update_alert_when: %w", err)
+		}
+		if matched {
+			result.Action = "update"
+			return result, nil
+		}
+	}
+	if urlMapping.OpenAlertWhen != nil {
+		matched, err := matchInboundCondition(urlMapping.OpenAlertWhen.Type, status, statusFound, urlMapping.OpenAlertWhen.Values)
+		if err != nil {
+			return nil, fmt.Errorf("open_alert_when: %w", err)
+		}
+		if !matched {
+			result.Action = "none"
+			return result, nil
+		}
+	}
+
+	result.Action = "open"
+	return result, nil
+}
+
+// evaluateInboundFilter evaluates a single JSON/form field filter
+func evaluateInboundFilter(payload interface{}, filter InboundIntegrationFilter) (bool, error) {
+	if filter.Condition == nil {
+		return !filter.Not, nil
+	}
+
+	// Filter conditions have no values to compare with, so value comparisons such
+	// as Equals can't be evaluated and would otherwise report a wrong result
+	if !containsStringFold(InboundFilterConditionTypes, strings.ReplaceAll(filter.Condition.Type, " ", "")) {
+		return false, fmt.Errorf("filter on %q: condition type %q compares against a value, which filters don't have (supported: %v)", filter.Condition.FieldName, filter.Condition.Type, InboundFilterConditionTypes)
+	}
+
+	value, found := lookupPayloadField(payload, filter.Condition.FieldName)
+	matched, err := matchInboundCondition(filter.Condition.Type, value, found, nil)
+	if err != nil {
+		return false, fmt.Errorf("filter on %q: %w", filter.Condition.FieldName, err)
+	}
+	return matched != filter.Not, nil
+}
+
+// flattenInboundFilterResult converts a filter evaluation to Terraform data
+func flattenInboundFilterResult(filterSet string, filter InboundIntegrationFilter, matched bool) map[string]interface{} {
+	result := map[string]interface{}{
+		"filter_set": filterSet,
+		"not":        filter.Not,
+		"matched":    matched,
+	}
+	if filter.Condition != nil {
+		result["field_name"] = filter.Condition.FieldName
+		result["type"] = filter.Condition.Type
+	}
+	return result
+}
+
+// matchInboundCondition compares a payload value with condition values. Condition
+// types are matched case-insensitively and ignoring spaces, so "Not Equals" and
+// "NotEquals" are the same. A condition matches if any of its values match.
+func matchInboundCondition(conditionType, value string, found bool, values []string) (bool, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(conditionType, " ", ""))
+	if normalized == "" {
+		normalized = "equals"
+	}
+
+	anyValue := func(match func(string) bool) bool {
+		for _, v := range values {
+			if match(v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch normalized {
+	case "exists":
+		return found, nil
+	case "notexists":
+		return !found, nil
+	case "empty":
+		return value == "", nil
+	case "notempty":
+		return value != "", nil
+	case "equals":
+		return found && anyValue(func(v string) bool { return strings.EqualFold(value, v) }), nil
+	case "notequals":
+		return !anyValue(func(v string) bool { return strings.EqualFold(value, v) }), nil
+	case "contains":
+		return found && anyValue(func(v string) bool { return strings.Contains(strings.ToLower(value), strings.ToLower(v)) }), nil
+	case "notcontains":
+		return !anyValue(func(v string) bool { return strings.Contains(strings.ToLower(value), strings.ToLower(v)) }), nil
+	case "startswith":
+		return found && anyValue(func(v string) bool { return strings.HasPrefix(strings.ToLower(value), strings.ToLower(v)) }), nil
+	case "endswith":
+		return found && anyValue(func(v string) bool { return strings.HasSuffix(strings.ToLower(value), strings.ToLower(v)) }), nil
+	case "matches", "regex":
+		for _, v := range values {
+			re, err := regexp.Compile(v)
+			if err != nil {
+				return false, fmt.Errorf("invalid regular expression %q: %w", v, err)
+			}
+			if found && re.MatchString(value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	return false, fmt.Errorf("unsupported condition type %q (supported: %v)", conditionType, InboundConditionTypes)
+}
+
+// lookupPayloadField resolves a field path such as "alert.labels.severity",
+// "alerts[0].status" or "$.alerts.0.status" in a decoded payload. Scalars are
+// returned as text and objects or arrays as compact JSON.
+func lookupPayloadField(payload interface{}, path string) (string, bool) {
+	path = strings.TrimPrefix(strings.TrimSpace(path), "$")
+	path = strings.NewReplacer("[", ".", "]", "").Replace(path)
+	if strings.Trim(path, ".") == "" {
+		return "", false
+	}
+
+	current := payload
+	for _, segment := range strings.Split(strings.Trim(path, "."), ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			next, ok := node[segment]
+			if !ok {
+				return "", false
+			}
+			current = next
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return "", false
+			}
+			current = node[index]
+		default:
+			return "", false
+		}
+	}
+
+	switch v := current.(type) {
+	case nil:
+		return "", true
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(encoded), true
+	}
+}
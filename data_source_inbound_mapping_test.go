@@ -0,0 +1,138 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatchInboundCondition(t *testing.T) {
+	cases := []struct {
+		conditionType string
+		value         string
+		found         bool
+		values        []string
+		want          bool
+	}{
+		{"Exists", "", true, nil, true},
+		{"Exists", "", false, nil, false},
+		{"NotExists", "", false, nil, true},
+		{"Empty", "", true, nil, true},
+		{"NotEmpty", "x", true, nil, true},
+		{"Equals", "Firing", true, []string{"resolved", "firing"}, true},
+		{"Equals", "", false, []string{""}, false},
+		{"", "firing", true, []string{"FIRING"}, true},
+		{"Not Equals", "firing", true, []string{"resolved"}, true},
+		{"NotEquals", "resolved", true, []string{"Resolved"}, false},
+		{"Contains", "disk full on db1", true, []string{"FULL"}, true},
+		{"NotContains", "disk full", true, []string{"cpu"}, true},
+		{"StartsWith", "CRITICAL: disk", true, []string{"critical"}, true},
+		{"EndsWith", "disk ok", true, []string{"OK"}, true},
+		{"EndsWith", "disk ok", true, []string{"disk"}, false},
+		{"Matches", "host-42", true, []string{`^host-\d+$`}, true},
+		{"Regex", "host-x", true, []string{`^host-\d+$`}, false},
+	}
+	for _, c := range cases {
+		got, err := matchInboundCondition(c.conditionType, c.value, c.found, c.values)
+		if err != nil {
+			t.Errorf("%s %q %v: %v", c.conditionType, c.value, c.values, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%s %q %v = %v, want %v", c.conditionType, c.value, c.values, got, c.want)
+		}
+	}
+}
+
+func TestMatchInboundConditionErrors(t *testing.T) {
+	if _, err := matchInboundCondition("GreaterThan", "1", true, []string{"0"}); err == nil {
+		t.Error("unsupported condition type: want an error")
+	}
+	if _, err := matchInboundCondition("Matches", "x", true, []string{"("}); err == nil {
+		t.Error("invalid regular expression: want an error")
+	}
+}
+
+func TestLookupPayloadField(t *testing.T) {
+	payload, err := parseInboundPayload(`{"alert":{"labels":{"severity":"critical"},"count":3,"ok":false,"note":null},"alerts":[{"status":"firing"},{"status":"resolved"}]}`, "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		path  string
+		want  string
+		found bool
+	}{
+		{"alert.labels.severity", "critical", true},
+		{"$.alert.labels.severity", "critical", true},
+		{"alerts[1].status", "resolved", true},
+		{"alerts.0.status", "firing", true},
+		{"alert.count", "3", true},
+		{"alert.ok", "false", true},
+		{"alert.note", "", true},
+		{"alert.labels", `{"severity":"critical"}`, true},
+		{"alert.missing", "", false},
+		{"alerts[2].status", "", false},
+		{"alerts.x", "", false},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		got, found := lookupPayloadField(payload, c.path)
+		if got != c.want || found != c.found {
+			t.Errorf("lookupPayloadField(%q) = %q, %v, want %q, %v", c.path, got, found, c.want, c.found)
+		}
+	}
+}
+
+func TestEvaluateInboundMappingAction(t *testing.T) {
+	urlMapping := &InboundIntegrationURLMapping{
+		OpenAlertWhen:   &InboundIntegrationCondition{FieldName: "status", Type: "Equals", Values: []string{"firing"}},
+		UpdateAlertWhen: &InboundIntegrationSimpleCondition{Type: "Equals", Values: []string{"acknowledged"}},
+		CloseAlertWhen:  &InboundIntegrationSimpleCondition{Type: "Equals", Values: []string{"resolved"}},
+	}
+	requireHost := &InboundIntegrationFilters{
+		AddAllFilter: &InboundIntegrationFilterSet{Filters: []InboundIntegrationFilter{
+			{Condition: &InboundIntegrationFilterCondition{FieldName: "host", Type: "Exists"}},
+		}},
+	}
+	cases := []struct {
+		payload string
+		filters *InboundIntegrationFilters
+		want    string
+	}{
+		{`{"status":"firing"}`, nil, "open"},
+		{`{"status":"acknowledged"}`, nil, "update"},
+		{`{"status":"resolved"}`, nil, "close"},
+		{`{"status":"pending"}`, nil, "none"},
+		{`{"status":"firing"}`, requireHost, "filtered"},
+		{`{"status":"firing","host":"db1"}`, requireHost, "open"},
+	}
+	for _, c := range cases {
+		payload, err := parseInboundPayload(c.payload, "json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := evaluateInboundMapping(payload, urlMapping, nil, c.filters)
+		if err != nil {
+			t.Errorf("%s: %v", c.payload, err)
+			continue
+		}
+		if result.Action != c.want {
+			t.Errorf("%s: action = %q, want %q", c.payload, result.Action, c.want)
+		}
+	}
+}
+
+func TestEvaluateInboundFilterRejectsValueComparisons(t *testing.T) {
+	payload, _ := parseInboundPayload(`{"host":"db1"}`, "json")
+	filter := InboundIntegrationFilter{Condition: &InboundIntegrationFilterCondition{FieldName: "host", Type: "Equals"}}
+	_, err := evaluateInboundFilter(payload, filter)
+	if err == nil || !strings.Contains(err.Error(), "compares against a value") {
+		t.Errorf("err = %v, want an error for a value comparison", err)
+	}
+
+	filter.Condition.Type = "Not Empty"
+	filter.Not = true
+	if matched, err := evaluateInboundFilter(payload, filter); err != nil || matched {
+		t.Errorf("not NotEmpty on a set field = %v, %v, want false", matched, err)
+	}
+}
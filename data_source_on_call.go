@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceOnCall returns who is on call for a group, now or at a given time
+func dataSourceOnCall() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOnCallRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the group",
+			},
+			"schedule_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only consider this schedule of the group (case-insensitive)",
+			},
+			"at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Time to look up, in RFC 3339 format. Defaults to the time of the read",
+				ValidateFunc: validateRFC3339,
+			},
+			"on_call": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Users on call, ordered by schedule name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User name",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Role of the user in the schedule",
+						},
+						"schedule_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Schedule the user is on call through",
+						},
+						"shift_start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start of the current shift (RFC 3339)",
+						},
+						"shift_end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "End of the current shift (RFC 3339); empty if the shift doesn't end",
+						},
+					},
+				},
+			},
+			"user_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "User names of the users on call, each listed once",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Where the answer came from: 'api', or 'schedules' if it was computed from the group's schedules",
+			},
+		},
+	}
+}
+
+func dataSourceOnCallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupName := d.Get("group").(string)
+	scheduleName := d.Get("schedule_name").(string)
+	at := time.Now()
+	if v, ok := d.GetOk("at"); ok {
+		at, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	source := "api"
+	onCall, err := getOnCall(ctx, client, groupName, at)
+	if isHTTPStatus(err, http.StatusNotFound, http.StatusMethodNotAllowed) {
+		log.Printf("[DEBUG] On-call lookup isn't available, computing it from the schedules of group %q", groupName)
+		source = "schedules"
+		onCall, err = computeOnCall(ctx, client, groupName, at)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result []interface{}
+	var userNames []string
+	for _, user := range onCall {
+		if scheduleName != "" && !strings.EqualFold(user.ScheduleName, scheduleName) {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"user_name":     user.UserName,
+			"role":          user.Role,
+			"schedule_name": user.ScheduleName,
+			"shift_start":   user.ShiftStart,
+			"shift_end":     user.ShiftEnd,
+		})
+		if !containsStringFold(userNames, user.UserName) {
+			userNames = append(userNames, user.UserName)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s|%s", groupName, scheduleName, at.UTC().Format(time.RFC3339)))
+	d.Set("on_call", result)
+	d.Set("user_names", userNames)
+	d.Set("source", source)
+
+	return nil
+}
+
+// getOnCall asks AlertOps who is on call for a group at a time
+func getOnCall(ctx context.Context, client *Client, groupName string, at time.Time) ([]OnCallUser, error) {
+	var onCall OnCallResponse
+	err := client.get(ctx, fmt.Sprintf("/api/v2/schedules/%s/oncall?at=%s", url.PathEscape(groupName), url.QueryEscape(at.Format(time.RFC3339))), &onCall)
+	if err != nil {
+		return nil, err
+	}
+	return onCall.OnCall, nil
+}
+
+// computeOnCall works out who is on call for a group at a time from the group's
+// enabled schedules, ordered by schedule name
+func computeOnCall(ctx context.Context, client *Client, groupName string, at time.Time) ([]OnCallUser, error) {
+	schedules, err := loadGroupSchedules(ctx, client, groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	var onCall []OnCallUser
+	for _, schedule := range schedules {
+		shifts, err := expandScheduleShifts(schedule, at, 1)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q: %w", schedule.ScheduleName, err)
+		}
+		if len(shifts) == 0 || shifts[0].Start.After(at) {
+			continue
+		}
+
+		for _, userName := range shifts[0].Users {
+			onCall = append(onCall, OnCallUser{
+				UserName:     userName,
+				Role:         scheduleUserRole(schedule.Users, userName),
+				ScheduleName: schedule.ScheduleName,
+				ShiftStart:   formatShiftTime(shifts[0].Start),
+				ShiftEnd:     formatShiftTime(shifts[0].End),
+			})
+		}
+	}
+	return onCall, nil
+}
+
+// scheduleUserRole returns the role of a user in a schedule
+func scheduleUserRole(users []ScheduleUser, userName string) string {
+	for _, user := range users {
+		if strings.EqualFold(user.User, userName) {
+			return user.Role
+		}
+	}
+	return ""
+}
+
+// validateRFC3339 checks that a string is a time in RFC 3339 format
+func validateRFC3339(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if _, err := time.Parse(time.RFC3339, v); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a time in RFC 3339 format such as 2025-01-02T15:04:05Z, got: %q", key, v))
+	}
+	return
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceScheduleCoverage reports gaps and overlaps in the coverage of a
+// group's schedules, and how many hours each user is on call
+func dataSourceScheduleCoverage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScheduleCoverageRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the group",
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Start of the window, in RFC 3339 format. Results are reported in its time zone. Defaults to the time of the read, in UTC",
+				ValidateFunc: validateRFC3339,
+			},
+			"days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultScheduleCoverageDays,
+				Description:  "Length of the window in days",
+				ValidateFunc: validateIntBetween(1, 366),
+			},
+			"schedule_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Enabled schedules of the group that were analyzed",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"gaps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Periods no schedule covers",
+				Elem:        getScheduleIntervalSchema(),
+			},
+			"overlaps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Periods two or more schedules cover",
+				Elem:        getScheduleIntervalSchema(),
+			},
+			"user_hours": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Hours each user is on call in the window, ordered by user name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User name",
+						},
+						"hours": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Hours on call, counted once per schedule",
+						},
+					},
+				},
+			},
+			"coverage_percent": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Percentage of the window covered by at least one schedule",
+			},
+			"fully_covered": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the window has no gaps",
+			},
+		},
+	}
+}
+
+func dataSourceScheduleCoverageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupName := d.Get("group").(string)
+	from := time.Now().UTC().Truncate(time.Minute)
+	if v, ok := d.GetOk("from"); ok {
+		from, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	to := from.AddDate(0, 0, d.Get("days").(int))
+
+	schedules, err := loadGroupSchedules(ctx, client, groupName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	coverage, err := analyzeScheduleCoverage(schedules, from, to)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	scheduleNames := make([]string, len(schedules))
+	for i, schedule := range schedules {
+		scheduleNames[i] = schedule.ScheduleName
+	}
+
+	userNames := make([]string, 0, len(coverage.UserHours))
+	for userName := range coverage.UserHours {
+		userNames = append(userNames, userName)
+	}
+	sort.Strings(userNames)
+	userHours := make([]interface{}, len(userNames))
+	for i, userName := range userNames {
+		userHours[i] = map[string]interface{}{
+			"user_name": userName,
+			"hours":     coverage.UserHours[userName],
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s|%s", groupName, formatShiftTime(from), formatShiftTime(to)))
+	d.Set("schedule_names", scheduleNames)
+	d.Set("gaps", flattenScheduleIntervals(coverage.Gaps))
+	d.Set("overlaps", flattenScheduleIntervals(coverage.Overlaps))
+	d.Set("user_hours", userHours)
+	d.Set("coverage_percent", math.Round(coverage.Covered.Seconds()/to.Sub(from).Seconds()*10000)/100)
+	d.Set("fully_covered", len(coverage.Gaps) == 0)
+
+	return nil
+}
+
+// Helper function to get schedule interval schema
+func getScheduleIntervalSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"start": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start of the period (RFC 3339)",
+			},
+			"end": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "End of the period (RFC 3339)",
+			},
+			"hours": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Length of the period in hours",
+			},
+			"schedules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Schedules covering the period; empty for gaps",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
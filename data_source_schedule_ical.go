@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Default number of days exported to a schedule calendar
+const defaultScheduleICalDays = 90
+
+// dataSourceScheduleICal renders the computed shifts of a schedule as an
+// iCalendar document
+func dataSourceScheduleICal() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScheduleICalRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the group the schedule belongs to",
+			},
+			"schedule_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "ID of the schedule",
+				ExactlyOneOf: []string{"schedule_id", "schedule_name"},
+			},
+			"schedule_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Name of the schedule, compared case-insensitively",
+				ExactlyOneOf: []string{"schedule_id", "schedule_name"},
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only include shifts this user is on call for (case-insensitive)",
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "First day (YYYY-MM-DD) to export, in the schedule's time zone. Defaults to the day of the read",
+				ValidateFunc: validateScheduleDate,
+			},
+			"days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultScheduleICalDays,
+				Description:  "Number of days to export",
+				ValidateFunc: validateIntBetween(1, 366),
+			},
+			"calendar_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name shown by calendar applications. Defaults to the group and schedule names",
+			},
+			"ics": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The calendar as an RFC 5545 iCalendar document",
+			},
+			"event_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of shifts in the calendar",
+			},
+		},
+	}
+}
+
+func dataSourceScheduleICalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupName := d.Get("group").(string)
+	scheduleID, ok := d.GetOk("schedule_id")
+	if !ok {
+		listed, err := listGroupSchedules(ctx, client, groupName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, schedule := range listed {
+			if strings.EqualFold(schedule.ScheduleName, d.Get("schedule_name").(string)) {
+				scheduleID = schedule.ScheduleID
+				break
+			}
+		}
+		if scheduleID == nil {
+			return diag.Errorf("group %q has no schedule named %q", groupName, d.Get("schedule_name").(string))
+		}
+	}
+
+	var schedule Schedule
+	err := client.get(ctx, fmt.Sprintf("/api/v2/schedules/%s/%d", url.PathEscape(groupName), scheduleID.(int)), &schedule)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read schedule: %w", err))
+	}
+	if schedule.IncludeAllUsersInGroup {
+		groupUsers, err := groupScheduleUsers(ctx, client, groupName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		schedule.Users = append(schedule.Users, groupUsers...)
+	}
+
+	location := timeZoneLocation(schedule.TimeZone)
+	now := time.Now().In(location)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	if v, ok := d.GetOk("from"); ok {
+		from, _ = time.ParseInLocation(scheduleDateLayout, v.(string), location)
+	}
+	to := from.AddDate(0, 0, d.Get("days").(int))
+
+	var shifts []ScheduleShift
+	if schedule.Enabled {
+		shifts, err = expandScheduleShiftsUntil(schedule, from, to, maxCoverageShifts)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if userName := d.Get("user_name").(string); userName != "" {
+		var filtered []ScheduleShift
+		for _, shift := range shifts {
+			if containsStringFold(shift.Users, userName) {
+				filtered = append(filtered, shift)
+			}
+		}
+		shifts = filtered
+	}
+
+	name := d.Get("calendar_name").(string)
+	if name == "" {
+		name = fmt.Sprintf("%s - %s", groupName, schedule.ScheduleName)
+	}
+	ics := icalCalendar{
+		Name:     name,
+		Schedule: schedule,
+		Shifts:   shifts,
+		Location: location,
+		From:     from,
+		To:       to,
+	}.render()
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(ics))))
+	d.Set("schedule_id", schedule.ScheduleID)
+	d.Set("schedule_name", schedule.ScheduleName)
+	d.Set("ics", ics)
+	d.Set("event_count", len(shifts))
+
+	return nil
+}
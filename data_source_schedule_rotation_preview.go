@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceScheduleRotationPreview computes the shifts of a schedule from the
+// same settings as alertops_schedule, without creating it
+func dataSourceScheduleRotationPreview() *schema.Resource {
+	scheduleSchema := resourceSchedule().Schema
+
+	previewSchema := map[string]*schema.Schema{
+		"shift_count": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      defaultRotationPreviewShifts,
+			Description:  "Number of shifts to list",
+			ValidateFunc: validateIntBetween(1, 100),
+		},
+		"from": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Date (YYYY-MM-DD) in the schedule's time zone from which to list shifts. Defaults to start_date",
+			ValidateFunc: validateScheduleDate,
+		},
+		"shifts": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Shifts, in order",
+			Elem:        getScheduleShiftSchema(),
+		},
+	}
+	for _, key := range schedulePreviewInputs {
+		inputSchema := *scheduleSchema[key]
+		previewSchema[key] = &inputSchema
+	}
+
+	// A preview is usually of a rotation
+	previewSchema["schedule_type"].Required = false
+	previewSchema["schedule_type"].Optional = true
+	previewSchema["schedule_type"].Default = "Rotating"
+	previewSchema["start_date"].Optional = false
+	previewSchema["start_date"].Required = true
+
+	return &schema.Resource{
+		ReadContext: dataSourceScheduleRotationPreviewRead,
+		Schema:      previewSchema,
+	}
+}
+
+func dataSourceScheduleRotationPreviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schedule := expandSchedulePreviewInput(d.Get)
+
+	from, err := expandRotationPreviewFrom(d.Get("from").(string), schedule.TimeZone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	shifts, err := expandScheduleShifts(schedule, from, d.Get("shift_count").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The ID identifies the inputs
+	scheduleJSON, _ := json.Marshal(schedule)
+	inputs := fmt.Sprintf("%s|%s|%d", scheduleJSON, d.Get("from").(string), d.Get("shift_count").(int))
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(inputs))))
+	d.Set("shifts", flattenScheduleShifts(shifts))
+
+	return nil
+}
+
+// Helper function to get schedule shift schema
+func getScheduleShiftSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"start": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start of the shift (RFC 3339, in the schedule's time zone)",
+			},
+			"end": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "End of the shift (RFC 3339, in the schedule's time zone); empty if the shift doesn't end",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Users on call during the shift",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
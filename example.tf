@@ -43,7 +43,7 @@ resource "alertops_user" "example_user" {
       phone = [
         {
           country_code = "+1"
-          phone_number = "5551234567"
+          phone_number = "4155551234"
         }
       ]
       enabled  = true
@@ -54,7 +54,7 @@ resource "alertops_user" "example_user" {
       sms = [
         {
           country_code = "+1"
-          phone_number = "5551234567"
+          phone_number = "4155551234"
         }
       ]
       enabled  = true
@@ -71,7 +71,7 @@ resource "alertops_user" "primary_oncall" {
   contact_methods {
     contact_method_name = "SMS-Official"
     sms {
-      phone_number = "555-0001"
+      phone_number = "415-555-0001"
       country_code = "1"
     }
     enabled = true
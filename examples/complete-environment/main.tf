@@ -87,7 +87,7 @@ resource "alertops_user" "infra_lead" {
   contact_methods {
     contact_method_name = "SMS-Official"
     sms {
-      phone_number = "555-1001"
+      phone_number = "415-555-1001"
       country_code = "1"
     }
     enabled = true
@@ -117,7 +117,7 @@ resource "alertops_user" "infra_engineer_1" {
   contact_methods {
     contact_method_name = "Phone-Official"
     phone {
-      phone_number = "555-1002"
+      phone_number = "415-555-1002"
       country_code = "1"
     }
     enabled = true
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Default depth to which nested groups are expanded into effective members
+const defaultGroupNestingDepth = 10
+
+// EffectiveMember is a user who is paged through a group, directly or through
+// nested groups
+type EffectiveMember struct {
+	UserName string
+	Via      []string // Nested groups between the group and the user, outermost first
+	Depth    int      // 0 for direct members
+}
+
+// groupMembershipLoader reads group members by group name for walking nested
+// groups. Groups are listed once; groups the list returns without members are
+// read individually.
+type groupMembershipLoader struct {
+	client *Client
+	cached bool
+	groups map[string]*Group
+}
+
+func newGroupMembershipLoader(client *Client, cached bool) *groupMembershipLoader {
+	return &groupMembershipLoader{client: client, cached: cached}
+}
+
+// members returns the members of the named group and whether the group exists
+func (l *groupMembershipLoader) members(ctx context.Context, groupName string) ([]GroupMember, bool, error) {
+	get := l.client.get
+	if l.cached {
+		get = l.client.getCached
+	}
+
+	if l.groups == nil {
+		groups, err := listGroups(ctx, l.client, l.cached)
+		if err != nil {
+			return nil, false, err
+		}
+		l.groups = make(map[string]*Group, len(groups))
+		for i := range groups {
+			l.groups[strings.ToLower(groups[i].GroupName)] = &groups[i]
+		}
+	}
+
+	group, ok := l.groups[strings.ToLower(groupName)]
+	if !ok {
+		return nil, false, nil
+	}
+
+	if group.Members == nil {
+		var full Group
+		if err := get(ctx, fmt.Sprintf("/api/v2/groups/%d", group.GroupID), &full); err != nil {
+			return nil, false, fmt.Errorf("failed to read group %q: %w", group.GroupName, err)
+		}
+		group.Members = full.Members
+		if group.Members == nil {
+			group.Members = []GroupMember{}
+		}
+	}
+	return group.Members, true, nil
+}
+
+// expandEffectiveMembers returns the users paged through members, expanding nested
+// groups breadth first up to maxDepth levels. Each user is listed once, through
+// the shortest path. truncated reports whether groups deeper than maxDepth were
+// skipped; groups already on the path are skipped so cycles terminate.
+func expandEffectiveMembers(ctx context.Context, loader *groupMembershipLoader, groupName string, members []GroupMember, maxDepth int) (result []EffectiveMember, truncated bool, err error) {
+	type level struct {
+		members []GroupMember
+		via     []string
+	}
+
+	seen := make(map[string]bool)
+	queue := []level{{members: members}}
+	for depth := 0; len(queue) > 0; depth++ {
+		var next []level
+		for _, l := range queue {
+			for _, member := range sortedGroupMembers(l.members) {
+				switch normalizeGroupMemberType(member.MemberType) {
+				case "User":
+					key := strings.ToLower(member.Member)
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+					result = append(result, EffectiveMember{UserName: member.Member, Via: l.via, Depth: depth})
+				case "Group":
+					if strings.EqualFold(member.Member, groupName) || containsStringFold(l.via, member.Member) {
+						continue
+					}
+					if depth+1 > maxDepth {
+						truncated = true
+						continue
+					}
+
+					nested, ok, err := loader.members(ctx, member.Member)
+					if err != nil {
+						return nil, false, err
+					}
+					if !ok {
+						continue
+					}
+					via := append(append([]string{}, l.via...), member.Member)
+					next = append(next, level{members: nested, via: via})
+				}
+			}
+		}
+		queue = next
+	}
+	return result, truncated, nil
+}
+
+// sortedGroupMembers returns members ordered by sequence
+func sortedGroupMembers(members []GroupMember) []GroupMember {
+	sorted := append([]GroupMember{}, members...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Sequence < sorted[j].Sequence
+	})
+	return sorted
+}
+
+// findGroupCycle returns the path of a cycle through groupName, e.g.
+// ["A", "B", "A"], given its planned nested groups, or nil. Other groups' members
+// come from the plans recorded by the provider in this run, then from AlertOps.
+// The recorded plans are local to this provider process and only hold the groups
+// planned so far, so a cycle between two new groups is found only when the second
+// of them is planned, and not at all if they're planned in separate runs.
+func findGroupCycle(ctx context.Context, client *Client, loader *groupMembershipLoader, groupName string, nestedGroups []string) ([]string, error) {
+	visited := make(map[string]bool)
+
+	var visit func(name string, path []string) ([]string, error)
+	visit = func(name string, path []string) ([]string, error) {
+		path = append(path, name)
+		if strings.EqualFold(name, groupName) {
+			return path, nil
+		}
+		if visited[strings.ToLower(name)] {
+			return nil, nil
+		}
+		visited[strings.ToLower(name)] = true
+
+		children, ok := client.plannedNestedGroups(name)
+		if !ok {
+			members, _, err := loader.members(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			for _, member := range members {
+				if normalizeGroupMemberType(member.MemberType) == "Group" {
+					children = append(children, member.Member)
+				}
+			}
+		}
+
+		for _, child := range children {
+			cycle, err := visit(child, path)
+			if cycle != nil || err != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+
+	for _, child := range nestedGroups {
+		cycle, err := visit(child, []string{groupName})
+		if cycle != nil || err != nil {
+			return cycle, err
+		}
+	}
+	return nil, nil
+}
+
+// flattenEffectiveMembers converts EffectiveMember structs to Terraform data
+func flattenEffectiveMembers(members []EffectiveMember) []interface{} {
+	result := make([]interface{}, len(members))
+	for i, member := range members {
+		via := make([]interface{}, len(member.Via))
+		for j, group := range member.Via {
+			via[j] = group
+		}
+		result[i] = map[string]interface{}{
+			"user_name": member.UserName,
+			"via":       via,
+			"depth":     member.Depth,
+		}
+	}
+	return result
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Earliest and latest years holidays are generated for
+const (
+	minHolidayYear = 1970
+	maxHolidayYear = 2100
+)
+
+// How a holiday falling on a weekend is observed
+const (
+	observeOnDay          = iota // Not moved
+	observeNearestWeekday        // Saturday moves to Friday, Sunday to Monday
+	observeNextWeekday           // Moves to the next weekday not already a holiday
+)
+
+// holidayRule describes how to find the date of a holiday in a year. A rule is
+// one of: a fixed Month and Day; the Nth Weekday of Month (-1 for the last); the
+// last Weekday on or before Month and Day (Nth = 0 with Weekday set); or
+// EasterOffset days after Easter Sunday (Easter set).
+type holidayRule struct {
+	Name         string
+	Month        time.Month
+	Day          int
+	Weekday      time.Weekday
+	Nth          int
+	Easter       bool
+	EasterOffset int
+	Observe      int
+	Since        int // First year the holiday applies, if not always
+}
+
+// Public holidays bundled with the provider, by ISO 3166-1 country code. These
+// are national holidays only; regional holidays and one-off closures need to be
+// added to a calendar explicitly.
+var holidayRules = map[string][]holidayRule{
+	"AU": {
+		{Name: "New Year's Day", Month: time.January, Day: 1, Observe: observeNextWeekday},
+		{Name: "Australia Day", Month: time.January, Day: 26, Observe: observeNextWeekday},
+		{Name: "Good Friday", Easter: true, EasterOffset: -2},
+		{Name: "Easter Monday", Easter: true, EasterOffset: 1},
+		{Name: "Anzac Day", Month: time.April, Day: 25},
+		{Name: "Christmas Day", Month: time.December, Day: 25, Observe: observeNextWeekday},
+		{Name: "Boxing Day", Month: time.December, Day: 26, Observe: observeNextWeekday},
+	},
+	"CA": {
+		{Name: "New Year's Day", Month: time.January, Day: 1, Observe: observeNextWeekday},
+		{Name: "Good Friday", Easter: true, EasterOffset: -2},
+		{Name: "Victoria Day", Month: time.May, Day: 24, Weekday: time.Monday},
+		{Name: "Canada Day", Month: time.July, Day: 1, Observe: observeNextWeekday},
+		{Name: "Labour Day", Month: time.September, Weekday: time.Monday, Nth: 1},
+		{Name: "National Day for Truth and Reconciliation", Month: time.September, Day: 30, Observe: observeNextWeekday, Since: 2021},
+		{Name: "Thanksgiving", Month: time.October, Weekday: time.Monday, Nth: 2},
+		{Name: "Remembrance Day", Month: time.November, Day: 11, Observe: observeNextWeekday},
+		{Name: "Christmas Day", Month: time.December, Day: 25, Observe: observeNextWeekday},
+		{Name: "Boxing Day", Month: time.December, Day: 26, Observe: observeNextWeekday},
+	},
+	"DE": {
+		{Name: "Neujahr", Month: time.January, Day: 1},
+		{Name: "Karfreitag", Easter: true, EasterOffset: -2},
+		{Name: "Ostermontag", Easter: true, EasterOffset: 1},
+		{Name: "Tag der Arbeit", Month: time.May, Day: 1},
+		{Name: "Christi Himmelfahrt", Easter: true, EasterOffset: 39},
+		{Name: "Pfingstmontag", Easter: true, EasterOffset: 50},
+		{Name: "Tag der Deutschen Einheit", Month: time.October, Day: 3},
+		{Name: "1. Weihnachtstag", Month: time.December, Day: 25},
+		{Name: "2. Weihnachtstag", Month: time.December, Day: 26},
+	},
+	"GB": {
+		{Name: "New Year's Day", Month: time.January, Day: 1, Observe: observeNextWeekday},
+		{Name: "Good Friday", Easter: true, EasterOffset: -2},
+		{Name: "Easter Monday", Easter: true, EasterOffset: 1},
+		{Name: "Early May bank holiday", Month: time.May, Weekday: time.Monday, Nth: 1},
+		{Name: "Spring bank holiday", Month: time.May, Weekday: time.Monday, Nth: -1},
+		{Name: "Summer bank holiday", Month: time.August, Weekday: time.Monday, Nth: -1},
+		{Name: "Christmas Day", Month: time.December, Day: 25, Observe: observeNextWeekday},
+		{Name: "Boxing Day", Month: time.December, Day: 26, Observe: observeNextWeekday},
+	},
+	"US": {
+		{Name: "New Year's Day", Month: time.January, Day: 1, Observe: observeNearestWeekday},
+		{Name: "Martin Luther King Jr. Day", Month: time.January, Weekday: time.Monday, Nth: 3},
+		{Name: "Washington's Birthday", Month: time.February, Weekday: time.Monday, Nth: 3},
+		{Name: "Memorial Day", Month: time.May, Weekday: time.Monday, Nth: -1},
+		{Name: "Juneteenth", Month: time.June, Day: 19, Observe: observeNearestWeekday, Since: 2021},
+		{Name: "Independence Day", Month: time.July, Day: 4, Observe: observeNearestWeekday},
+		{Name: "Labor Day", Month: time.September, Weekday: time.Monday, Nth: 1},
+		{Name: "Columbus Day", Month: time.October, Weekday: time.Monday, Nth: 2},
+		{Name: "Veterans Day", Month: time.November, Day: 11, Observe: observeNearestWeekday},
+		{Name: "Thanksgiving Day", Month: time.November, Weekday: time.Thursday, Nth: 4},
+		{Name: "Christmas Day", Month: time.December, Day: 25, Observe: observeNearestWeekday},
+	},
+}
+
+// generateHolidays returns the public holidays of a country in a year, in date
+// order. Holidays falling on a weekend are listed on the day they're observed.
+func generateHolidays(country string, year int) ([]Holiday, error) {
+	rules, ok := holidayRules[country]
+	if !ok {
+		return nil, fmt.Errorf("no holiday rules for country %q; supported countries are %v", country, HolidayCountries)
+	}
+	if year < minHolidayYear || year > maxHolidayYear {
+		return nil, fmt.Errorf("year %d is outside %d-%d", year, minHolidayYear, maxHolidayYear)
+	}
+
+	taken := make(map[string]bool)
+	var holidays []Holiday
+	// Holidays that don't move are placed first, so that moved ones skip them
+	for _, pass := range []bool{false, true} {
+		for _, rule := range rules {
+			if rule.Since > year {
+				continue
+			}
+			day := rule.date(year)
+			weekend := day.Weekday() == time.Saturday || day.Weekday() == time.Sunday
+			if (rule.Observe == observeNextWeekday && weekend) != pass {
+				continue
+			}
+			switch rule.Observe {
+			case observeNearestWeekday:
+				if day.Weekday() == time.Saturday {
+					day = day.AddDate(0, 0, -1)
+				} else if day.Weekday() == time.Sunday {
+					day = day.AddDate(0, 0, 1)
+				}
+			case observeNextWeekday:
+				for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday || taken[day.Format(scheduleDateLayout)] {
+					day = day.AddDate(0, 0, 1)
+				}
+			}
+			taken[day.Format(scheduleDateLayout)] = true
+			holidays = append(holidays, Holiday{Date: day.Format(scheduleDateLayout), Name: rule.Name})
+		}
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date < holidays[j].Date
+	})
+	return holidays, nil
+}
+
+// date returns the day a rule falls on in a year, before any weekend move
+func (r holidayRule) date(year int) time.Time {
+	switch {
+	case r.Easter:
+		return easterSunday(year).AddDate(0, 0, r.EasterOffset)
+	case r.Nth > 0:
+		first := time.Date(year, r.Month, 1, 0, 0, 0, 0, time.UTC)
+		return first.AddDate(0, 0, (int(r.Weekday)-int(first.Weekday())+7)%7+7*(r.Nth-1))
+	case r.Nth < 0:
+		last := time.Date(year, r.Month+1, 0, 0, 0, 0, 0, time.UTC)
+		return last.AddDate(0, 0, -((int(last.Weekday()) - int(r.Weekday) + 7) % 7))
+	case r.Weekday != time.Sunday:
+		day := time.Date(year, r.Month, r.Day, 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -((int(day.Weekday()) - int(r.Weekday) + 7) % 7))
+	default:
+		return time.Date(year, r.Month, r.Day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// easterSunday returns the date of Easter Sunday in the Gregorian calendar,
+// using the anonymous Gregorian algorithm
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// HolidayShift is a holiday on which a schedule has shifts, with the users on
+// call that day
+type HolidayShift struct {
+	Holiday Holiday
+	Users   []string
+}
+
+// scheduleHolidayShifts returns the holidays that fall inside any of the shifts,
+// in date order. A holiday is the whole day in location; its users are everyone
+// on call at some point that day.
+func scheduleHolidayShifts(shifts []ScheduleShift, holidays []Holiday, location *time.Location) []HolidayShift {
+	sorted := append([]Holiday(nil), holidays...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date < sorted[j].Date
+	})
+
+	var result []HolidayShift
+	for _, holiday := range sorted {
+		dayStart, err := time.ParseInLocation(scheduleDateLayout, holiday.Date, location)
+		if err != nil {
+			continue
+		}
+		dayEnd := dayStart.AddDate(0, 0, 1)
+
+		found := false
+		var users []string
+		for _, shift := range shifts {
+			if !shift.Start.Before(dayEnd) || (!shift.End.IsZero() && !shift.End.After(dayStart)) {
+				continue
+			}
+			found = true
+			for _, user := range shift.Users {
+				if !containsStringFold(users, user) {
+					users = append(users, user)
+				}
+			}
+		}
+		if found {
+			result = append(result, HolidayShift{Holiday: holiday, Users: users})
+		}
+	}
+	return result
+}
+
+// flattenHolidayShifts converts HolidayShift structs to Terraform data
+func flattenHolidayShifts(holidayShifts []HolidayShift) []interface{} {
+	result := make([]interface{}, len(holidayShifts))
+	for i, holidayShift := range holidayShifts {
+		users := make([]interface{}, len(holidayShift.Users))
+		for j, user := range holidayShift.Users {
+			users[j] = user
+		}
+		result[i] = map[string]interface{}{
+			"date":  holidayShift.Holiday.Date,
+			"name":  holidayShift.Holiday.Name,
+			"users": users,
+		}
+	}
+	return result
+}
+
+// Helper function to get holiday shift schema
+func getHolidayShiftSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date of the holiday (YYYY-MM-DD)",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the holiday",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Users on call at some point during the holiday",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGenerateHolidaysWeekendChristmas(t *testing.T) {
+	cases := []struct {
+		country string
+		year    int
+		want    map[string]string // Holiday name to observed date
+	}{
+		// Christmas on a Saturday, Boxing Day on a Sunday
+		{"GB", 2021, map[string]string{"Christmas Day": "2021-12-27", "Boxing Day": "2021-12-28"}},
+		{"AU", 2021, map[string]string{"Christmas Day": "2021-12-27", "Boxing Day": "2021-12-28"}},
+		{"CA", 2021, map[string]string{"Christmas Day": "2021-12-27", "Boxing Day": "2021-12-28"}},
+		// Christmas on a Sunday; Boxing Day keeps the Monday
+		{"GB", 2022, map[string]string{"Christmas Day": "2022-12-27", "Boxing Day": "2022-12-26"}},
+		{"AU", 2022, map[string]string{"Christmas Day": "2022-12-27", "Boxing Day": "2022-12-26"}},
+		// Boxing Day on a Saturday moves past the Sunday
+		{"GB", 2020, map[string]string{"Christmas Day": "2020-12-25", "Boxing Day": "2020-12-28"}},
+		{"DE", 2021, map[string]string{"1. Weihnachtstag": "2021-12-25", "2. Weihnachtstag": "2021-12-26"}},
+		{"US", 2021, map[string]string{"Christmas Day": "2021-12-24"}},
+		{"US", 2022, map[string]string{"Christmas Day": "2022-12-26"}},
+	}
+	for _, c := range cases {
+		holidays, err := generateHolidays(c.country, c.year)
+		if err != nil {
+			t.Fatalf("%s %d: %v", c.country, c.year, err)
+		}
+		got := make(map[string]string)
+		for _, holiday := range holidays {
+			if _, ok := c.want[holiday.Name]; ok {
+				got[holiday.Name] = holiday.Date
+			}
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s %d: got %v, want %v", c.country, c.year, got, c.want)
+		}
+	}
+}
+
+func TestGenerateHolidays(t *testing.T) {
+	cases := []struct {
+		country string
+		year    int
+		want    []Holiday
+	}{
+		{"GB", 2024, []Holiday{
+			{Date: "2024-01-01", Name: "New Year's Day"},
+			{Date: "2024-03-29", Name: "Good Friday"},
+			{Date: "2024-04-01", Name: "Easter Monday"},
+			{Date: "2024-05-06", Name: "Early May bank holiday"},
+			{Date: "2024-05-27", Name: "Spring bank holiday"},
+			{Date: "2024-08-26", Name: "Summer bank holiday"},
+			{Date: "2024-12-25", Name: "Christmas Day"},
+			{Date: "2024-12-26", Name: "Boxing Day"},
+		}},
+		// New Year's Day 2022 is a Saturday, so it's observed on the last day of 2021
+		{"US", 2022, []Holiday{
+			{Date: "2021-12-31", Name: "New Year's Day"},
+			{Date: "2022-01-17", Name: "Martin Luther King Jr. Day"},
+			{Date: "2022-02-21", Name: "Washington's Birthday"},
+			{Date: "2022-05-30", Name: "Memorial Day"},
+			{Date: "2022-06-20", Name: "Juneteenth"},
+			{Date: "2022-07-04", Name: "Independence Day"},
+			{Date: "2022-09-05", Name: "Labor Day"},
+			{Date: "2022-10-10", Name: "Columbus Day"},
+			{Date: "2022-11-11", Name: "Veterans Day"},
+			{Date: "2022-11-24", Name: "Thanksgiving Day"},
+			{Date: "2022-12-26", Name: "Christmas Day"},
+		}},
+	}
+	for _, c := range cases {
+		got, err := generateHolidays(c.country, c.year)
+		if err != nil {
+			t.Fatalf("%s %d: %v", c.country, c.year, err)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s %d: got %v, want %v", c.country, c.year, got, c.want)
+		}
+	}
+}
+
+func TestGenerateHolidaysRules(t *testing.T) {
+	cases := []struct {
+		country string
+		year    int
+		name    string
+		want    string // Empty if the holiday doesn't apply that year
+	}{
+		{"US", 2020, "Juneteenth", ""},
+		{"US", 2021, "Juneteenth", "2021-06-18"},
+		{"CA", 2020, "National Day for Truth and Reconciliation", ""},
+		{"CA", 2021, "National Day for Truth and Reconciliation", "2021-09-30"},
+		// Last Monday on or before 24 May
+		{"CA", 2021, "Victoria Day", "2021-05-24"},
+		{"CA", 2022, "Victoria Day", "2022-05-23"},
+		{"DE", 2024, "Christi Himmelfahrt", "2024-05-09"},
+		{"DE", 2024, "Pfingstmontag", "2024-05-20"},
+		// Anzac Day isn't moved
+		{"AU", 2021, "Anzac Day", "2021-04-25"},
+	}
+	for _, c := range cases {
+		holidays, err := generateHolidays(c.country, c.year)
+		if err != nil {
+			t.Fatalf("%s %d: %v", c.country, c.year, err)
+		}
+		got := ""
+		for _, holiday := range holidays {
+			if holiday.Name == c.name {
+				got = holiday.Date
+			}
+		}
+		if got != c.want {
+			t.Errorf("%s %d %s: got %q, want %q", c.country, c.year, c.name, got, c.want)
+		}
+	}
+}
+
+func TestGenerateHolidaysErrors(t *testing.T) {
+	if _, err := generateHolidays("XX", 2024); err == nil {
+		t.Error("expected an error for an unknown country")
+	}
+	for _, year := range []int{minHolidayYear - 1, maxHolidayYear + 1} {
+		if _, err := generateHolidays("US", year); err == nil {
+			t.Errorf("expected an error for %d", year)
+		}
+	}
+}
+
+func TestEasterSunday(t *testing.T) {
+	cases := map[int]string{
+		1970: "1970-03-29",
+		2008: "2008-03-23",
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2038: "2038-04-25",
+	}
+	for year, want := range cases {
+		if got := easterSunday(year).Format(scheduleDateLayout); got != want {
+			t.Errorf("easterSunday(%d) = %s, want %s", year, got, want)
+		}
+	}
+}
+
+func TestScheduleHolidayShifts(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(day, hour int) time.Time {
+		return time.Date(2024, time.December, day, hour, 0, 0, 0, newYork)
+	}
+
+	shifts := []ScheduleShift{
+		{Start: at(23, 8), End: at(24, 0), Users: []string{"carol"}},
+		{Start: at(24, 20), End: at(25, 8), Users: []string{"alice"}},
+		{Start: at(25, 8), Users: []string{"bob", "Alice"}},
+	}
+	holidays := []Holiday{
+		{Date: "2024-12-25", Name: "Christmas Day"},
+		{Date: "2024-12-24", Name: "Christmas Eve"},
+		{Date: "2024-12-22", Name: "Before any shift"},
+	}
+
+	want := []HolidayShift{
+		{Holiday: Holiday{Date: "2024-12-24", Name: "Christmas Eve"}, Users: []string{"alice"}},
+		{Holiday: Holiday{Date: "2024-12-25", Name: "Christmas Day"}, Users: []string{"alice", "bob"}},
+	}
+	if got := scheduleHolidayShifts(shifts, holidays, newYork); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -25,6 +25,21 @@ type Group struct {
 	ContactMethods []GroupContactMethod   `json:"contact_methods,omitempty"`
 	Topics         []string               `json:"topics,omitempty"`
 	Attributes     []GroupAttribute       `json:"attributes,omitempty"`
+	DynamicRules   []GroupDynamicRule     `json:"dynamic_rules,omitempty"`
+}
+
+// GroupUpdateRequest represents the request body for updating a group. Lists are
+// always sent so that removing every entry in config clears them in AlertOps.
+type GroupUpdateRequest struct {
+	GroupID        int                  `json:"group_id,omitempty"`
+	GroupName      string               `json:"group_name"`
+	Dynamic        bool                 `json:"dynamic"`
+	Description    []string             `json:"description"`
+	Members        []GroupMember        `json:"members"`
+	ContactMethods []GroupContactMethod `json:"contact_methods"`
+	Topics         []string             `json:"topics"`
+	Attributes     []GroupAttribute     `json:"attributes"`
+	DynamicRules   []GroupDynamicRule   `json:"dynamic_rules"`
 }
 
 // GroupMember represents a member of a group (user or another group)
@@ -35,6 +50,37 @@ type GroupMember struct {
 	Roles      []string `json:"roles,omitempty"` // e.g., ["Primary", "Manager"]
 }
 
+// Valid group member types
+var GroupMemberTypes = []string{
+	"User",
+	"Group",
+}
+
+// GroupDynamicRule is a predicate on users that decides a dynamic group's members
+type GroupDynamicRule struct {
+	RuleType      string `json:"rule_type"`                // "user_attribute", "role" or "time_zone"
+	AttributeName string `json:"attribute_name,omitempty"` // for user_attribute rules
+	Operator      string `json:"operator"`
+	Value         string `json:"value"`
+}
+
+// Valid dynamic group rule types
+var GroupDynamicRuleTypes = []string{
+	"user_attribute",
+	"role",
+	"time_zone",
+}
+
+// Valid dynamic group rule operators. time_zone rules only support equals and
+// not_equals.
+var GroupDynamicRuleOperators = []string{
+	"equals",
+	"not_equals",
+	"contains",
+	"starts_with",
+	"ends_with",
+}
+
 // GroupContactMethod represents contact methods for groups
 type GroupContactMethod struct {
 	ContactMethodName string `json:"contact_method_name"`
@@ -75,7 +121,7 @@ type ContactMethod struct {
 	RepeatMinutes           int                `json:"repeat_minutes,omitempty"`
 	NotificationTime24x7    bool               `json:"notification_time24x7,omitempty"`
 	NotificationTimes       []NotificationTime `json:"notification_times,omitempty"`
-	Enabled                 bool               `json:"enabled,omitempty"`
+	Enabled                 bool               `json:"enabled"`
 	Sequence                int                `json:"sequence,omitempty"`
 }
 
@@ -93,6 +139,20 @@ var ValidContactMethodTypes = []string{
 	"SMS-Personal",
 }
 
+// Deletion policies for resources whose removal destroys history or breaks
+// references: delete the object, deactivate it in place, or only drop it from state
+var DeletionPolicies = []string{
+	"delete",
+	"deactivate",
+	"retain",
+}
+
+// Deletion policies supported by groups, which cannot be deactivated
+var GroupDeletionPolicies = []string{
+	"delete",
+	"retain",
+}
+
 // EmailContact represents email contact information
 type EmailContact struct {
 	EmailAddress string `json:"email_address"`
@@ -152,8 +212,19 @@ type UserCreateRequest struct {
 	Roles          []string        `json:"roles,omitempty"`
 }
 
-// UserUpdateRequest represents the request body for updating a user (same as create)
-type UserUpdateRequest = UserCreateRequest
+// UserUpdateRequest represents the request body for updating a user. Lists are
+// always sent so that removing every entry in config clears them in AlertOps.
+type UserUpdateRequest struct {
+	UserName       string          `json:"user_name"`
+	FirstName      string          `json:"first_name"`
+	LastName       string          `json:"last_name"`
+	Locale         string          `json:"locale,omitempty"`
+	TimeZone       string          `json:"time_zone,omitempty"`
+	Type           string          `json:"type,omitempty"`
+	ExternalID     string          `json:"external_id,omitempty"`
+	ContactMethods []ContactMethod `json:"contact_methods"`
+	Roles          []string        `json:"roles"`
+}
 
 // UserListResponse represents the response for listing users
 type UserListResponse struct {
@@ -185,6 +256,31 @@ type Schedule struct {
 	Users                    []ScheduleUser   `json:"users,omitempty"`
 	Enabled                  bool             `json:"enabled"`
 	IsHolidayNotify          bool             `json:"is_holiday_notify"`
+	HolidayCalendarID        int              `json:"holiday_calendar_id,omitempty"`
+}
+
+// Valid schedule types
+var ScheduleTypes = []string{
+	"Fixed",
+	"Rotating",
+}
+
+// Valid schedule rotation frequencies
+var RotateFrequencies = []string{
+	"daily",
+	"weekly",
+	"monthly",
+}
+
+// Weekday names used by schedules, in time.Weekday order
+var ScheduleWeekdayNames = []string{
+	"Sun",
+	"Mon",
+	"Tue",
+	"Wed",
+	"Thu",
+	"Fri",
+	"Sat",
 }
 
 // ScheduleDate represents a date with hour and minute
@@ -252,6 +348,55 @@ type ScheduleListResponse struct {
 	Offset    int        `json:"offset"`
 }
 
+// ScheduleOverride represents a temporary change to who is on call in a schedule
+type ScheduleOverride struct {
+	OverrideID   int           `json:"override_id,omitempty"`
+	ScheduleID   int           `json:"schedule_id"`
+	Group        string        `json:"group"`
+	User         string        `json:"user"`
+	ReplacedUser string        `json:"replaced_user,omitempty"`
+	TimeZone     string        `json:"time_zone"`
+	StartDate    *ScheduleDate `json:"start_date"`
+	EndDate      *ScheduleDate `json:"end_date"`
+}
+
+// HolidayCalendar represents a named set of holidays that schedules can refer to
+type HolidayCalendar struct {
+	HolidayCalendarID int       `json:"holiday_calendar_id,omitempty"`
+	Name              string    `json:"name"`
+	Holidays          []Holiday `json:"holidays"`
+}
+
+// Holiday represents a day in a holiday calendar
+type Holiday struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+// Countries with holiday rules bundled with the provider
+var HolidayCountries = []string{
+	"AU",
+	"CA",
+	"DE",
+	"GB",
+	"US",
+}
+
+// OnCallResponse represents the users on call for a group at a time
+type OnCallResponse struct {
+	Group  string       `json:"group"`
+	OnCall []OnCallUser `json:"on_call"`
+}
+
+// OnCallUser represents a user on call through a schedule
+type OnCallUser struct {
+	UserName     string `json:"user_name"`
+	Role         string `json:"role"`
+	ScheduleName string `json:"schedule_name"`
+	ShiftStart   string `json:"shift_start"`
+	ShiftEnd     string `json:"shift_end"`
+}
+
 // Workflow represents a workflow in AlertOps
 type Workflow struct {
 	WorkflowID           int                 `json:"workflow_id,omitempty"`
@@ -446,6 +591,80 @@ type InboundIntegration struct {
 	EmailSettings          *InboundIntegrationEmailSettings `json:"email_settings,omitempty"`
 	ChatSettings           *InboundIntegrationChatSettings `json:"chat_settings,omitempty"`
 	HeartbeatSettings      *InboundIntegrationHeartbeatSettings `json:"heartbeat_settings,omitempty"`
+	IntegrationURL         string                       `json:"integration_url,omitempty"`  // read-only
+	IntegrationKey         string                       `json:"integration_key,omitempty"`  // read-only
+	MailBoxAddress         string                       `json:"mail_box_address,omitempty"` // read-only
+}
+
+// InboundIntegrationListResponse represents the response for listing inbound integrations
+type InboundIntegrationListResponse struct {
+	Limit               int                  `json:"limit"`
+	Offset              int                  `json:"offset"`
+	InboundIntegrations []InboundIntegration `json:"inbound_integrations"`
+}
+
+// Type-specific settings blocks on alertops_inbound_integration
+var InboundIntegrationSettingsBlocks = []string{
+	"api_settings",
+	"email_settings",
+	"chat_settings",
+	"mail_box",
+	"bridge",
+	"heartbeat_settings",
+}
+
+// Settings blocks accepted by each inbound integration type
+var InboundIntegrationTypeBlocks = map[string][]string{
+	"API":             {"api_settings", "bridge", "heartbeat_settings"},
+	"Email":           {"email_settings", "mail_box", "bridge"},
+	"Chat":            {"chat_settings"},
+	"Slack":           {"chat_settings"},
+	"Microsoft Teams": {"chat_settings"},
+	"Heartbeat":       {"heartbeat_settings"},
+	"Bridge":          {"bridge", "heartbeat_settings"},
+}
+
+// Inbound integration types that require a mail_box
+var EmailInboundIntegrationTypes = []string{
+	"Email",
+}
+
+// Condition types understood when evaluating inbound integration mappings
+var InboundConditionTypes = []string{
+	"Equals",
+	"NotEquals",
+	"Contains",
+	"NotContains",
+	"StartsWith",
+	"EndsWith",
+	"Matches",
+	"Exists",
+	"NotExists",
+	"Empty",
+	"NotEmpty",
+}
+
+// Condition types a JSON/form field filter can be evaluated with. Filter
+// conditions have no values, so only types that test the field itself apply.
+var InboundFilterConditionTypes = []string{
+	"Exists",
+	"NotExists",
+	"Empty",
+	"NotEmpty",
+}
+
+// InboundTemplate represents an entry in the inbound integration template catalog
+type InboundTemplate struct {
+	InboundTemplateID int    `json:"inbound_template_id"`
+	TemplateName      string `json:"template_name"`
+	Type              string `json:"type,omitempty"`
+}
+
+// InboundTemplateListResponse represents the response for listing inbound templates
+type InboundTemplateListResponse struct {
+	Limit     int               `json:"limit"`
+	Offset    int               `json:"offset"`
+	Templates []InboundTemplate `json:"templates"`
 }
 
 // InboundIntegrationBridge represents bridge settings
@@ -785,6 +1004,13 @@ type InboundIntegrationChatSettings struct {
 	EscalationPolicyOverride *InboundIntegrationEscalationPolicyOverride     `json:"escalation_policy_override,omitempty"`
 }
 
+// Inbound integration types that accept chat_settings
+var ChatInboundIntegrationTypes = []string{
+	"Chat",
+	"Slack",
+	"Microsoft Teams",
+}
+
 // InboundIntegrationChatURLMapping represents chat URL mapping
 type InboundIntegrationChatURLMapping struct {
 	Source      string `json:"source,omitempty"`
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nyaruka/phonenumbers"
+)
+
+// parsePhoneNumber parses a phone number in the context of an international calling
+// code such as "1" or "+44". Numbers already written in international form must
+// match the calling code.
+func parsePhoneNumber(countryCode, phoneNumber string) (*phonenumbers.PhoneNumber, error) {
+	code, err := strconv.Atoi(normalizeCountryCode(countryCode))
+	if err != nil {
+		return nil, fmt.Errorf("country_code %q is not a numeric calling code", countryCode)
+	}
+
+	region := phonenumbers.GetRegionCodeForCountryCode(code)
+	if region == "ZZ" {
+		return nil, fmt.Errorf("country_code %q is not a known calling code", countryCode)
+	}
+
+	number, err := phonenumbers.Parse(phoneNumber, region)
+	if err != nil {
+		return nil, fmt.Errorf("phone_number %q could not be parsed: %v", phoneNumber, err)
+	}
+
+	if int(number.GetCountryCode()) != code {
+		return nil, fmt.Errorf("phone_number %q belongs to calling code +%d, not country_code %q", phoneNumber, number.GetCountryCode(), countryCode)
+	}
+
+	return number, nil
+}
+
+// validatePhoneNumber checks that a number is valid for its calling code and, when
+// mobileOnly is set, that it is a number that can receive SMS
+func validatePhoneNumber(countryCode, phoneNumber string, mobileOnly bool) error {
+	number, err := parsePhoneNumber(countryCode, phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	if !phonenumbers.IsValidNumber(number) {
+		return fmt.Errorf("phone_number %q is not a valid number for country_code %q", phoneNumber, countryCode)
+	}
+
+	if mobileOnly {
+		switch phonenumbers.GetNumberType(number) {
+		case phonenumbers.MOBILE, phonenumbers.FIXED_LINE_OR_MOBILE:
+		default:
+			return fmt.Errorf("phone_number %q is not a mobile number", phoneNumber)
+		}
+	}
+
+	return nil
+}
+
+// phoneNumberE164 returns the E.164 form of a number, or "" if it cannot be parsed
+func phoneNumberE164(countryCode, phoneNumber string) string {
+	if phoneNumber == "" {
+		return ""
+	}
+
+	number, err := parsePhoneNumber(countryCode, phoneNumber)
+	if err != nil {
+		return ""
+	}
+	return phonenumbers.Format(number, phonenumbers.E164)
+}
+
+// normalizePhoneNumber returns a comparable form of a number: E.164 when it can be
+// parsed, otherwise its digits
+func normalizePhoneNumber(countryCode, phoneNumber string) string {
+	if e164 := phoneNumberE164(countryCode, phoneNumber); e164 != "" {
+		return e164
+	}
+	return phonenumbers.NormalizeDigitsOnly(phoneNumber)
+}
+
+// normalizeCountryCode strips formatting from a calling code, e.g. "+1" to "1"
+func normalizeCountryCode(countryCode string) string {
+	return strings.TrimPrefix(strings.TrimSpace(countryCode), "+")
+}
+
+// isMobileContactMethod reports whether a contact method name requires a mobile number
+func isMobileContactMethod(contactMethodName string) bool {
+	return strings.HasPrefix(contactMethodName, "SMS-") || strings.HasSuffix(contactMethodName, "-Mobile")
+}
+
+// suppressEquivalentPhoneNumber suppresses diffs between formattings of the same
+// number, e.g. "555-0100" and "5550100". The sibling country_code gives the context.
+func suppressEquivalentPhoneNumber(k, old, new string, d *schema.ResourceData) bool {
+	countryCode, _ := d.Get(strings.TrimSuffix(k, "phone_number") + "country_code").(string)
+	return normalizePhoneNumber(countryCode, old) == normalizePhoneNumber(countryCode, new)
+}
+
+// suppressEquivalentCountryCode suppresses diffs such as "+1" vs "1"
+func suppressEquivalentCountryCode(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeCountryCode(old) == normalizeCountryCode(new)
+}
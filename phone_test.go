@@ -0,0 +1,88 @@
+package main
+
+import "testing"
+
+func TestPhoneNumberE164(t *testing.T) {
+	cases := []struct {
+		countryCode string
+		phoneNumber string
+		want        string
+	}{
+		{"1", "(202) 456-1111", "+12024561111"},
+		{"+1", "202.456.1111", "+12024561111"},
+		{" 1 ", "+1 202 456 1111", "+12024561111"},
+		{"44", "020 7946 0018", "+442079460018"},
+		{"44", "07400 123456", "+447400123456"},
+		{"49", "030 123456", "+4930123456"},
+		{"44", "+1 202 456 1111", ""},
+		{"999", "12345", ""},
+		{"x", "12345", ""},
+		{"1", "", ""},
+	}
+	for _, c := range cases {
+		if got := phoneNumberE164(c.countryCode, c.phoneNumber); got != c.want {
+			t.Errorf("phoneNumberE164(%q, %q) = %q, want %q", c.countryCode, c.phoneNumber, got, c.want)
+		}
+	}
+}
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	cases := []struct {
+		countryCode string
+		a, b        string
+		equal       bool
+	}{
+		{"1", "202-456-1111", "2024561111", true},
+		{"1", "+1 (202) 456-1111", "202 456 1111", true},
+		{"1", "202-456-1111", "202-456-1112", false},
+		// Unparseable numbers compare by digits
+		{"999", "12-34", "1234", true},
+	}
+	for _, c := range cases {
+		got := normalizePhoneNumber(c.countryCode, c.a) == normalizePhoneNumber(c.countryCode, c.b)
+		if got != c.equal {
+			t.Errorf("normalizePhoneNumber(%q) %q vs %q equal = %v, want %v", c.countryCode, c.a, c.b, got, c.equal)
+		}
+	}
+}
+
+func TestValidatePhoneNumber(t *testing.T) {
+	cases := []struct {
+		countryCode string
+		phoneNumber string
+		mobileOnly  bool
+		wantErr     bool
+	}{
+		{"1", "202-456-1111", false, false},
+		// North American numbers can't be told apart and pass as mobile
+		{"1", "202-456-1111", true, false},
+		{"44", "07400 123456", true, false},
+		{"44", "020 7946 0018", false, false},
+		{"44", "020 7946 0018", true, true},
+		{"44", "12345", false, true},
+		{"1", "+44 20 7946 0018", false, true},
+		{"abc", "2024561111", false, true},
+		{"999", "2024561111", false, true},
+	}
+	for _, c := range cases {
+		err := validatePhoneNumber(c.countryCode, c.phoneNumber, c.mobileOnly)
+		if (err != nil) != c.wantErr {
+			t.Errorf("validatePhoneNumber(%q, %q, %v) = %v, want error: %v", c.countryCode, c.phoneNumber, c.mobileOnly, err, c.wantErr)
+		}
+	}
+}
+
+func TestIsMobileContactMethod(t *testing.T) {
+	cases := map[string]bool{
+		"SMS-Official":   true,
+		"Phone-Mobile":   true,
+		"Phone-Official": false,
+		"Email-Official": false,
+		"sms-official":   false,
+	}
+	for name, want := range cases {
+		if got := isMobileContactMethod(name); got != want {
+			t.Errorf("isMobileContactMethod(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -24,17 +24,43 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ALERTOPS_BASE_URL", "https://api.alertops.com"),
 				Description: "AlertOps API Base URL",
 			},
+			"warn_on_schedule_gaps": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Warn after creating or updating an alertops_schedule whose group is left without coverage during the next schedule_gap_check_days days. Only warns and never fails a plan or apply. Best effort: schedules applied later in the same run may still close the gaps",
+			},
+			"schedule_gap_check_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultScheduleCoverageDays,
+				Description:  "Number of days checked by warn_on_schedule_gaps",
+				ValidateFunc: validateIntBetween(1, 90),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"alertops_user":                 resourceUser(),
 			"alertops_group":                resourceGroup(),
+			"alertops_group_member":         resourceGroupMember(),
 			"alertops_schedule":             resourceSchedule(),
 			"alertops_workflow":             resourceWorkflow(),
 			"alertops_escalation_policy":    resourceEscalationPolicy(),
 			"alertops_inbound_integration":  resourceInboundIntegration(),
+			"alertops_schedule_override":    resourceScheduleOverride(),
+			"alertops_holiday_calendar":     resourceHolidayCalendar(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"alertops_user": dataSourceUser(),
+			"alertops_user":                      dataSourceUser(),
+			"alertops_group":                     dataSourceGroup(),
+			"alertops_groups":                    dataSourceGroups(),
+			"alertops_group_effective_members":   dataSourceGroupEffectiveMembers(),
+			"alertops_group_selection":           dataSourceGroupSelection(),
+			"alertops_inbound_integration":       dataSourceInboundIntegration(),
+			"alertops_inbound_mapping_test":      dataSourceInboundMappingTest(),
+			"alertops_schedule_rotation_preview": dataSourceScheduleRotationPreview(),
+			"alertops_on_call":                   dataSourceOnCall(),
+			"alertops_schedule_coverage":         dataSourceScheduleCoverage(),
+			"alertops_schedule_ical":             dataSourceScheduleICal(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -66,6 +92,8 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 
 	client := NewClient(apiKey, baseURL)
+	client.warnOnScheduleGaps = d.Get("warn_on_schedule_gaps").(bool)
+	client.scheduleGapCheckDays = d.Get("schedule_gap_check_days").(int)
 	
 	return client, diags
 } 
\ No newline at end of file
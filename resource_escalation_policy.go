@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -50,6 +51,7 @@ func resourceEscalationPolicy() *schema.Resource {
 				Required:    true,
 				Description: "Whether quick launch is enabled",
 			},
+			"deletion_policy": getDeletionPolicySchema(DeletionPolicies, "deactivate disables the policy but keeps it for alerts and integrations that reference it"),
 			"notify_using_centralized_settings": {
 				Type:        schema.TypeBool,
 				Required:    true,
@@ -562,6 +564,11 @@ func resourceEscalationPolicyRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("outbound_actions", flattenOutboundActions(escalationPolicy.OutboundActions))
 	d.Set("options", flattenOptions(escalationPolicy.Options))
 
+	// deletion_policy is not stored in AlertOps; default it for imported policies
+	if _, ok := d.GetOk("deletion_policy"); !ok {
+		d.Set("deletion_policy", "delete")
+	}
+
 	return nil
 }
 
@@ -620,9 +627,26 @@ func resourceEscalationPolicyDelete(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*Client)
 
 	escalationPolicyID := d.Id()
-	err := client.delete(ctx, fmt.Sprintf("/api/v2/escalation_policies/%s", escalationPolicyID))
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting escalation policy: %v", err))
+	switch d.Get("deletion_policy").(string) {
+	case "retain":
+		log.Printf("[INFO] Retaining escalation policy %s in AlertOps, removing it from state only", escalationPolicyID)
+	case "deactivate":
+		var escalationPolicy EscalationPolicy
+		err := client.get(ctx, fmt.Sprintf("/api/v2/escalation_policies/%s", escalationPolicyID), &escalationPolicy)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading escalation policy: %v", err))
+		}
+
+		escalationPolicy.Enabled = false
+		err = client.put(ctx, fmt.Sprintf("/api/v2/escalation_policies/%s", escalationPolicyID), escalationPolicy, nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error deactivating escalation policy: %v", err))
+		}
+	default:
+		err := client.delete(ctx, fmt.Sprintf("/api/v2/escalation_policies/%s", escalationPolicyID))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting escalation policy: %v", err))
+		}
 	}
 
 	d.SetId("")
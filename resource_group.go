@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,6 +20,7 @@ func resourceGroup() *schema.Resource {
 		ReadContext:   resourceGroupRead,
 		UpdateContext: resourceGroupUpdate,
 		DeleteContext: resourceGroupDelete,
+		CustomizeDiff: resourceGroupCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -50,15 +53,11 @@ func resourceGroup() *schema.Resource {
 				Description: "List of group members (users or other groups)",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"member_type": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Type of member: 'User' or 'Group'",
-						},
+						"member_type": getGroupMemberTypeSchema(false),
 						"member": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "Username or group name",
+							Description: "Username or group name, or a user or group ID",
 						},
 						"sequence": {
 							Type:        schema.TypeInt,
@@ -93,14 +92,21 @@ func resourceGroup() *schema.Resource {
 							Description: "Email address (for email-based methods)",
 						},
 						"country_code": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Country code (for phone/SMS methods)",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "Country calling code (for phone/SMS methods), e.g. 1 or 44",
+							DiffSuppressFunc: suppressEquivalentCountryCode,
 						},
 						"phone_number": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "Phone number (for phone/SMS methods). Validated against country_code; formatting differences are ignored",
+							DiffSuppressFunc: suppressEquivalentPhoneNumber,
+						},
+						"e164": {
 							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Phone number (for phone/SMS methods)",
+							Computed:    true,
+							Description: "Phone number in E.164 format",
 						},
 						"extension": {
 							Type:        schema.TypeString,
@@ -158,6 +164,41 @@ func resourceGroup() *schema.Resource {
 					},
 				},
 			},
+			"dynamic_rules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules that decide the members of a dynamic group. Requires dynamic = true and can't be combined with members",
+				Elem:        getGroupDynamicRuleSchema(),
+			},
+			"compute_effective_members": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Expand nested groups into effective_members on every refresh. Reads every nested group, so it is off by default",
+			},
+			"effective_members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("Users paged through this group, with nested groups expanded up to %d levels deep. Only set when compute_effective_members is true", defaultGroupNestingDepth),
+				Elem:        getEffectiveMemberSchema(),
+			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "On create, take over an existing group with the same group_name instead of failing",
+			},
+			"adopt_if_topic": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only adopt an existing group that has this topic",
+			},
+			"adopted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the group already existed and was adopted rather than created by Terraform",
+			},
+			"deletion_policy": getDeletionPolicySchema(GroupDeletionPolicies, ""),
 			"debug_request_json": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -170,6 +211,31 @@ func resourceGroup() *schema.Resource {
 func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client)
 
+	if d.Get("adopt_existing").(bool) {
+		existing, err := findGroupByName(ctx, client, d.Get("group_name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if existing != nil {
+			if topic, ok := d.GetOk("adopt_if_topic"); ok && !containsString(existing.Topics, topic.(string)) {
+				return diag.Errorf("group %q already exists without topic %q; refusing to adopt it", existing.GroupName, topic.(string))
+			}
+
+			log.Printf("[INFO] Adopting existing group %s (%d)", existing.GroupName, existing.GroupID)
+			d.SetId(strconv.Itoa(existing.GroupID))
+			d.Set("group_id", existing.GroupID)
+			d.Set("adopted", true)
+
+			// Don't keep a failed adoption in state, where it would be tainted and the
+			// next apply would destroy a group Terraform did not create
+			diags := resourceGroupUpdate(ctx, d, meta)
+			if diags.HasError() {
+				d.SetId("")
+			}
+			return diags
+		}
+	}
+
 	group := Group{
 		GroupName: d.Get("group_name").(string),
 		Dynamic:   d.Get("dynamic").(bool),
@@ -188,6 +254,9 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	// Handle members
 	if v, ok := d.GetOk("members"); ok {
 		group.Members = expandGroupMembers(v.([]interface{}))
+		if err := newGroupMemberResolver(client, false).resolveAll(ctx, group.Members); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	// Handle contact methods
@@ -210,6 +279,11 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		group.Attributes = expandGroupAttributes(v.([]interface{}))
 	}
 
+	// Handle dynamic rules
+	if v, ok := d.GetOk("dynamic_rules"); ok {
+		group.DynamicRules = expandGroupDynamicRules(v.([]interface{}))
+	}
+
 	// Store the request JSON for debugging
 	requestJSON, _ := json.Marshal(group)
 	d.Set("debug_request_json", string(requestJSON))
@@ -222,6 +296,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(strconv.Itoa(createdGroup.GroupID))
 	d.Set("group_id", createdGroup.GroupID)
+	d.Set("adopted", false)
 
 	return resourceGroupRead(ctx, d, meta)
 }
@@ -242,16 +317,38 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("description", group.Description)
 	d.Set("topics", group.Topics)
 
-	if len(group.Members) > 0 {
-		d.Set("members", flattenGroupMembers(group.Members))
+	// Always set lists so that entries removed outside Terraform show as drift.
+	// Dynamic groups' members are computed by AlertOps from dynamic_rules, so they
+	// aren't configuration and would only cause churn.
+	if group.Dynamic {
+		d.Set("members", nil)
+	} else {
+		members := flattenGroupMembers(group.Members)
+		if err := preserveGroupMemberReferences(ctx, client, d.Get("members").([]interface{}), members); err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("members", members)
 	}
+	d.Set("dynamic_rules", flattenGroupDynamicRules(group.DynamicRules))
 
-	if len(group.ContactMethods) > 0 {
-		d.Set("contact_methods", flattenGroupContactMethods(group.ContactMethods))
+	if d.Get("compute_effective_members").(bool) {
+		effectiveMembers, truncated, err := expandEffectiveMembers(ctx, newGroupMembershipLoader(client, false), group.GroupName, group.Members, defaultGroupNestingDepth)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if truncated {
+			log.Printf("[WARN] Group %s nests groups more than %d levels deep; effective_members is incomplete", group.GroupName, defaultGroupNestingDepth)
+		}
+		d.Set("effective_members", flattenEffectiveMembers(effectiveMembers))
+	} else {
+		d.Set("effective_members", nil)
 	}
+	d.Set("contact_methods", flattenGroupContactMethods(group.ContactMethods))
+	d.Set("attributes", flattenGroupAttributes(group.Attributes))
 
-	if len(group.Attributes) > 0 {
-		d.Set("attributes", flattenGroupAttributes(group.Attributes))
+	// deletion_policy is not stored in AlertOps; default it for imported groups
+	if _, ok := d.GetOk("deletion_policy"); !ok {
+		d.Set("deletion_policy", "delete")
 	}
 
 	return nil
@@ -261,10 +358,18 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	client := meta.(*Client)
 
 	groupID := d.Id()
-	group := Group{
+	group := GroupUpdateRequest{
 		GroupID:   d.Get("group_id").(int),
 		GroupName: d.Get("group_name").(string),
 		Dynamic:   d.Get("dynamic").(bool),
+
+		// Sent even when empty so that removing them in config clears them in AlertOps
+		Description:    []string{},
+		Members:        []GroupMember{},
+		ContactMethods: []GroupContactMethod{},
+		Topics:         []string{},
+		Attributes:     []GroupAttribute{},
+		DynamicRules:   []GroupDynamicRule{},
 	}
 
 	// Handle description array
@@ -280,6 +385,9 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	// Handle members
 	if v, ok := d.GetOk("members"); ok {
 		group.Members = expandGroupMembers(v.([]interface{}))
+		if err := newGroupMemberResolver(client, false).resolveAll(ctx, group.Members); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	// Handle contact methods
@@ -302,6 +410,28 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		group.Attributes = expandGroupAttributes(v.([]interface{}))
 	}
 
+	// Handle dynamic rules
+	if v, ok := d.GetOk("dynamic_rules"); ok {
+		group.DynamicRules = expandGroupDynamicRules(v.([]interface{}))
+	}
+
+	unlock := client.lock(groupLockKey(groupID))
+	defer unlock()
+
+	// Keep the current members when they haven't changed, e.g. with
+	// ignore_changes = [members] while alertops_group_member manages them. Dynamic
+	// groups' members are computed by AlertOps and aren't sent back.
+	if !d.HasChange("members") && !group.Dynamic {
+		var current Group
+		err := client.get(ctx, fmt.Sprintf("/api/v2/groups/%s", groupID), &current)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to read group: %w", err))
+		}
+		if current.Members != nil {
+			group.Members = current.Members
+		}
+	}
+
 	// Store the request JSON for debugging
 	requestJSON, _ := json.Marshal(group)
 	d.Set("debug_request_json", string(requestJSON))
@@ -319,6 +449,16 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	client := meta.(*Client)
 
 	groupID := d.Id()
+	if d.Get("deletion_policy").(string) == "retain" {
+		log.Printf("[INFO] Retaining group %s in AlertOps, removing it from state only", groupID)
+		d.SetId("")
+		return nil
+	}
+
+	if d.Get("adopted").(bool) {
+		log.Printf("[WARN] Deleting group %s, which existed before Terraform adopted it", groupID)
+	}
+
 	err := client.delete(ctx, fmt.Sprintf("/api/v2/groups/%s", groupID))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete group: %w", err))
@@ -328,6 +468,526 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
+// Helper function to get dynamic rule schema
+func getGroupDynamicRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"rule_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "What the rule tests: 'user_attribute', 'role' or 'time_zone'",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !containsString(GroupDynamicRuleTypes, v) {
+						errs = append(errs, fmt.Errorf("%q must be one of %v, got: %q", key, GroupDynamicRuleTypes, v))
+					}
+					return
+				},
+			},
+			"attribute_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User attribute to test (user_attribute rules only)",
+			},
+			"operator": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "equals",
+				Description: "Comparison operator. time_zone rules support only equals and not_equals",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !containsString(GroupDynamicRuleOperators, v) {
+						errs = append(errs, fmt.Errorf("%q must be one of %v, got: %q", key, GroupDynamicRuleOperators, v))
+					}
+					return
+				},
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Value to compare with. For time_zone rules, an AlertOps, IANA or Windows time zone",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					ruleType, _ := d.Get(strings.TrimSuffix(k, "value") + "rule_type").(string)
+					return ruleType == "time_zone" && suppressEquivalentTimeZone(k, old, new, d)
+				},
+			},
+		},
+	}
+}
+
+// validateGroupDynamicRules checks that dynamic_rules are only used on dynamic
+// groups, instead of members, and that each rule is complete
+func validateGroupDynamicRules(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("dynamic") || !d.NewValueKnown("dynamic_rules") || !d.NewValueKnown("members") {
+		return nil
+	}
+
+	dynamic := d.Get("dynamic").(bool)
+	rules := d.Get("dynamic_rules").([]interface{})
+	members := d.Get("members").([]interface{})
+
+	if len(rules) > 0 && len(members) > 0 {
+		return fmt.Errorf("members and dynamic_rules can't both be set; a dynamic group's members come from its rules")
+	}
+	if len(rules) > 0 && !dynamic {
+		return fmt.Errorf("dynamic_rules requires dynamic = true")
+	}
+	if dynamic && len(members) > 0 {
+		return fmt.Errorf("members can't be set on a dynamic group; use dynamic_rules")
+	}
+
+	for i, ruleData := range rules {
+		if ruleData == nil {
+			continue
+		}
+		rule := ruleData.(map[string]interface{})
+		ruleType := rule["rule_type"].(string)
+		attributeName := rule["attribute_name"].(string)
+		operator := rule["operator"].(string)
+		value := rule["value"].(string)
+
+		switch ruleType {
+		case "user_attribute":
+			if attributeName == "" && d.NewValueKnown(fmt.Sprintf("dynamic_rules.%d.attribute_name", i)) {
+				return fmt.Errorf("dynamic_rules.%d: attribute_name is required for user_attribute rules", i)
+			}
+		default:
+			if attributeName != "" {
+				return fmt.Errorf("dynamic_rules.%d: attribute_name is only valid for user_attribute rules", i)
+			}
+		}
+
+		if ruleType == "time_zone" {
+			if operator != "equals" && operator != "not_equals" {
+				return fmt.Errorf("dynamic_rules.%d: time_zone rules support only equals and not_equals, got: %q", i, operator)
+			}
+			if d.NewValueKnown(fmt.Sprintf("dynamic_rules.%d.value", i)) {
+				if _, errs := validateTimeZone(value, fmt.Sprintf("dynamic_rules.%d.value", i)); len(errs) > 0 {
+					return errs[0]
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// expandGroupDynamicRules converts Terraform data to GroupDynamicRule structs
+func expandGroupDynamicRules(rules []interface{}) []GroupDynamicRule {
+	result := make([]GroupDynamicRule, 0, len(rules))
+	for _, ruleData := range rules {
+		if ruleData == nil {
+			continue
+		}
+		rule := ruleData.(map[string]interface{})
+
+		groupRule := GroupDynamicRule{
+			RuleType:      rule["rule_type"].(string),
+			AttributeName: rule["attribute_name"].(string),
+			Operator:      rule["operator"].(string),
+			Value:         rule["value"].(string),
+		}
+		if groupRule.RuleType == "time_zone" {
+			groupRule.Value = alertOpsTimeZoneName(groupRule.Value)
+		}
+
+		result = append(result, groupRule)
+	}
+	return result
+}
+
+// flattenGroupDynamicRules converts GroupDynamicRule structs to Terraform data
+func flattenGroupDynamicRules(rules []GroupDynamicRule) []interface{} {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	result := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		result[i] = map[string]interface{}{
+			"rule_type":      rule.RuleType,
+			"attribute_name": rule.AttributeName,
+			"operator":       rule.Operator,
+			"value":          rule.Value,
+		}
+	}
+	return result
+}
+
+// Helper function to get member_type schema. Values are matched case-insensitively
+// and stored as AlertOps spells them.
+func getGroupMemberTypeSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    forceNew,
+		Description: "Type of member: 'User' or 'Group'",
+		StateFunc: func(val interface{}) string {
+			return normalizeGroupMemberType(val.(string))
+		},
+		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+			v := val.(string)
+			if !containsString(GroupMemberTypes, normalizeGroupMemberType(v)) {
+				errs = append(errs, fmt.Errorf("%q must be one of %v, got: %q", key, GroupMemberTypes, v))
+			}
+			return
+		},
+	}
+}
+
+// normalizeGroupMemberType returns a member type as AlertOps spells it, e.g. "user"
+// to "User", or the value unchanged if it isn't a known type
+func normalizeGroupMemberType(memberType string) string {
+	for _, t := range GroupMemberTypes {
+		if strings.EqualFold(t, memberType) {
+			return t
+		}
+	}
+	return memberType
+}
+
+// groupMemberResolver resolves member references, a user or group name in any case
+// or a numeric ID, to the name AlertOps expects. Lists are fetched at most once per
+// resolver; cached resolvers use the provider-wide plan cache and fall back to a
+// fresh list before reporting a member as missing.
+type groupMemberResolver struct {
+	client *Client
+	cached bool
+	users  []User
+	groups []Group
+}
+
+func newGroupMemberResolver(client *Client, cached bool) *groupMemberResolver {
+	return &groupMemberResolver{client: client, cached: cached}
+}
+
+// resolve returns the name a reference points to, or "" if nothing matches
+func (r *groupMemberResolver) resolve(ctx context.Context, memberType, ref string) (string, error) {
+	name, err := r.lookup(ctx, memberType, ref)
+	if name != "" || err != nil || !r.cached {
+		return name, err
+	}
+
+	// The member may have been created since the cached list was fetched
+	r.cached = false
+	r.users, r.groups = nil, nil
+	return r.lookup(ctx, memberType, ref)
+}
+
+func (r *groupMemberResolver) lookup(ctx context.Context, memberType, ref string) (string, error) {
+	switch normalizeGroupMemberType(memberType) {
+	case "User":
+		if r.users == nil {
+			users, err := listUsers(ctx, r.client, r.cached)
+			if err != nil {
+				return "", err
+			}
+			r.users = users
+		}
+		for _, user := range r.users {
+			if strings.EqualFold(user.UserName, ref) {
+				return user.UserName, nil
+			}
+		}
+		for _, user := range r.users {
+			if strconv.Itoa(user.UserID) == ref {
+				return user.UserName, nil
+			}
+		}
+	case "Group":
+		if r.groups == nil {
+			groups, err := listGroups(ctx, r.client, r.cached)
+			if err != nil {
+				return "", err
+			}
+			r.groups = groups
+		}
+		for _, group := range r.groups {
+			if strings.EqualFold(group.GroupName, ref) {
+				return group.GroupName, nil
+			}
+		}
+		for _, group := range r.groups {
+			if strconv.Itoa(group.GroupID) == ref {
+				return group.GroupName, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// check logs a warning if a reference doesn't match an existing user or group,
+// or one planned so far in this run. It can't fail: a literal name for a user or
+// group in the same configuration has no dependency edge, so whether it has been
+// planned yet depends on Terraform's plan order, and the planned names start
+// empty again when the plan is recomputed during apply. AlertOps rejects members
+// that really don't exist on apply.
+func (r *groupMemberResolver) check(ctx context.Context, memberType, ref string) error {
+	name, err := r.resolve(ctx, memberType, ref)
+	if err != nil || name != "" {
+		return err
+	}
+
+	memberType = normalizeGroupMemberType(memberType)
+	if !r.client.isPlannedName(memberType, ref) {
+		log.Printf("[WARN] %s %q was not found in AlertOps or among the objects planned so far; leaving it to AlertOps to check on apply", memberType, ref)
+	}
+	return nil
+}
+
+// resolveAll replaces each member reference with the name AlertOps expects
+func (r *groupMemberResolver) resolveAll(ctx context.Context, members []GroupMember) error {
+	for i := range members {
+		name, err := r.resolve(ctx, members[i].MemberType, members[i].Member)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			if err := r.check(ctx, members[i].MemberType, members[i].Member); err != nil {
+				return err
+			}
+			continue
+		}
+		members[i].Member = name
+	}
+	return nil
+}
+
+// preserveGroupMemberReferences keeps member references from state, such as IDs or
+// names in a different case, when they still resolve to the member AlertOps
+// returned, so they don't show as a diff against the names the API reports. The
+// reference at the same position is preferred and each is used once.
+func preserveGroupMemberReferences(ctx context.Context, client *Client, prior []interface{}, members []interface{}) error {
+	used := make([]bool, len(prior))
+
+	// candidates returns the indexes of unused prior references of the member's
+	// type, starting with the one at position i
+	candidates := func(i int, member map[string]interface{}) []int {
+		var result []int
+		for _, j := range append([]int{i}, rangeExcept(len(prior), i)...) {
+			if j >= len(prior) || used[j] || prior[j] == nil {
+				continue
+			}
+			priorMember := prior[j].(map[string]interface{})
+			memberType, _ := priorMember["member_type"].(string)
+			if normalizeGroupMemberType(memberType) == member["member_type"] {
+				result = append(result, j)
+			}
+		}
+		return result
+	}
+
+	var resolver *groupMemberResolver
+	for i, memberData := range members {
+		member := memberData.(map[string]interface{})
+
+		matched := false
+		for _, j := range candidates(i, member) {
+			if prior[j].(map[string]interface{})["member"] == member["member"] {
+				used[j], matched = true, true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+
+		for _, j := range candidates(i, member) {
+			ref, _ := prior[j].(map[string]interface{})["member"].(string)
+			if resolver == nil {
+				resolver = newGroupMemberResolver(client, false)
+			}
+			name, err := resolver.resolve(ctx, member["member_type"].(string), ref)
+			if err != nil {
+				return err
+			}
+			if name == member["member"] {
+				member["member"] = ref
+				used[j] = true
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// rangeExcept returns 0..n-1 without skip
+func rangeExcept(n, skip int) []int {
+	result := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		if i != skip {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// groupLockKey returns the client lock key for read-modify-write updates to a group
+func groupLockKey(groupID string) string {
+	return fmt.Sprintf("/api/v2/groups/%s", groupID)
+}
+
+// groupUpdateRequest converts a group read from AlertOps to an update request that
+// sends it back unchanged
+func groupUpdateRequest(group Group) GroupUpdateRequest {
+	request := GroupUpdateRequest{
+		GroupID:        group.GroupID,
+		GroupName:      group.GroupName,
+		Dynamic:        group.Dynamic,
+		Description:    group.Description,
+		Members:        group.Members,
+		ContactMethods: group.ContactMethods,
+		Topics:         group.Topics,
+		Attributes:     group.Attributes,
+		DynamicRules:   group.DynamicRules,
+	}
+
+	if request.Description == nil {
+		request.Description = []string{}
+	}
+	if request.Members == nil {
+		request.Members = []GroupMember{}
+	}
+	if request.ContactMethods == nil {
+		request.ContactMethods = []GroupContactMethod{}
+	}
+	if request.Topics == nil {
+		request.Topics = []string{}
+	}
+	if request.Attributes == nil {
+		request.Attributes = []GroupAttribute{}
+	}
+	if request.DynamicRules == nil {
+		request.DynamicRules = []GroupDynamicRule{}
+	}
+	return request
+}
+
+// Number of groups requested per page when listing groups
+const groupsPageSize = 100
+
+// listGroups returns every group, following pagination. Cached listings use the
+// provider-wide plan cache.
+func listGroups(ctx context.Context, client *Client, cached bool) ([]Group, error) {
+	get := client.get
+	if cached {
+		get = client.getCached
+	}
+
+	var groups []Group
+	for offset := 0; ; offset += groupsPageSize {
+		var listResponse GroupsResponse
+		err := get(ctx, fmt.Sprintf("/api/v2/groups?limit=%d&offset=%d", groupsPageSize, offset), &listResponse)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list groups: %w", err)
+		}
+
+		groups = append(groups, listResponse.Groups...)
+		if len(listResponse.Groups) < groupsPageSize {
+			return groups, nil
+		}
+	}
+}
+
+// findGroupByName returns the group with the given group_name, compared
+// case-insensitively, or nil if there is none
+func findGroupByName(ctx context.Context, client *Client, groupName string) (*Group, error) {
+	groups, err := listGroups(ctx, client, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range groups {
+		if strings.EqualFold(groups[i].GroupName, groupName) {
+			return &groups[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// resourceGroupCustomizeDiff checks dynamic rules, that members exist and don't
+// form cycles, and validates contact method phone numbers
+func resourceGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("compute_effective_members") || (d.Get("compute_effective_members").(bool) && (d.HasChange("members") || d.HasChange("dynamic_rules"))) {
+		d.SetNewComputed("effective_members")
+	}
+
+	if err := validateGroupDynamicRules(d); err != nil {
+		return err
+	}
+
+	client, ok := meta.(*Client)
+	if ok && d.NewValueKnown("group_name") {
+		client.addPlannedName("Group", d.Get("group_name").(string))
+	}
+
+	if ok && d.NewValueKnown("members") {
+		resolver := newGroupMemberResolver(client, true)
+		var nestedGroups []string
+		for i := range d.Get("members").([]interface{}) {
+			memberTypeKey := fmt.Sprintf("members.%d.member_type", i)
+			memberKey := fmt.Sprintf("members.%d.member", i)
+			if !d.NewValueKnown(memberTypeKey) || !d.NewValueKnown(memberKey) {
+				continue
+			}
+			memberType := d.Get(memberTypeKey).(string)
+			if err := resolver.check(ctx, memberType, d.Get(memberKey).(string)); err != nil {
+				return fmt.Errorf("members.%d: %v", i, err)
+			}
+			if normalizeGroupMemberType(memberType) == "Group" {
+				name, _ := resolver.resolve(ctx, memberType, d.Get(memberKey).(string))
+				if name == "" {
+					// Not in AlertOps yet, so it is a group planned in this run
+					name = d.Get(memberKey).(string)
+				}
+				nestedGroups = append(nestedGroups, name)
+			}
+		}
+
+		if d.NewValueKnown("group_name") {
+			groupName := d.Get("group_name").(string)
+			client.setPlannedNestedGroups(groupName, nestedGroups)
+
+			cycle, err := findGroupCycle(ctx, client, newGroupMembershipLoader(client, true), groupName, nestedGroups)
+			if err != nil {
+				return err
+			}
+			if cycle != nil {
+				return fmt.Errorf("members: nested groups form a cycle: %s", strings.Join(cycle, " -> "))
+			}
+		}
+	}
+
+	if !d.NewValueKnown("contact_methods") {
+		return nil
+	}
+
+	for i, contactMethodData := range d.Get("contact_methods").([]interface{}) {
+		if contactMethodData == nil {
+			continue
+		}
+		cm := contactMethodData.(map[string]interface{})
+		name := cm["contact_method_name"].(string)
+
+		if !d.NewValueKnown(fmt.Sprintf("contact_methods.%d.phone_number", i)) || !d.NewValueKnown(fmt.Sprintf("contact_methods.%d.country_code", i)) {
+			continue
+		}
+		phoneNumber, _ := cm["phone_number"].(string)
+		if phoneNumber == "" {
+			continue
+		}
+		countryCode, _ := cm["country_code"].(string)
+		if countryCode == "" {
+			return fmt.Errorf("contact_methods.%d (%s): country_code is required when phone_number is set", i, name)
+		}
+		if err := validatePhoneNumber(countryCode, phoneNumber, isMobileContactMethod(name)); err != nil {
+			return fmt.Errorf("contact_methods.%d (%s): %v", i, name, err)
+		}
+	}
+
+	return nil
+}
+
 // Helper functions for expanding and flattening complex structures
 
 func expandGroupMembers(members []interface{}) []GroupMember {
@@ -340,7 +1000,7 @@ func expandGroupMembers(members []interface{}) []GroupMember {
 		member := memberData.(map[string]interface{})
 		
 		groupMember := GroupMember{
-			MemberType: member["member_type"].(string),
+			MemberType: normalizeGroupMemberType(member["member_type"].(string)),
 			Member:     member["member"].(string),
 			Sequence:   member["sequence"].(int),
 		}
@@ -446,6 +1106,7 @@ func flattenGroupContactMethods(contactMethods []GroupContactMethod) []interface
 		}
 		if cm.PhoneNumber != "" {
 			contactMethodMap["phone_number"] = cm.PhoneNumber
+			contactMethodMap["e164"] = phoneNumberE164(cm.CountryCode, cm.PhoneNumber)
 		}
 		if cm.Extension != "" {
 			contactMethodMap["extension"] = cm.Extension
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceGroupMember manages a single member of a group without touching the
+// others. Don't also set members on the alertops_group for the same group unless
+// it has lifecycle { ignore_changes = [members] }, or each will remove the
+// other's members.
+func resourceGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupMemberCreate,
+		ReadContext:   resourceGroupMemberRead,
+		UpdateContext: resourceGroupMemberUpdate,
+		DeleteContext: resourceGroupMemberDelete,
+		CustomizeDiff: resourceGroupMemberCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGroupMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the group to add the member to",
+			},
+			"member_type": getGroupMemberTypeSchema(true),
+			"member": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Username or group name, or a user or group ID",
+			},
+			"sequence": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Sequence order",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Roles for this member (e.g., Primary, Manager)",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupID := strconv.Itoa(d.Get("group_id").(int))
+	members := []GroupMember{expandGroupMember(d)}
+	if err := newGroupMemberResolver(client, false).resolveAll(ctx, members); err != nil {
+		return diag.FromErr(err)
+	}
+	member := members[0]
+
+	err := updateGroupMembers(ctx, client, groupID, func(members []GroupMember) ([]GroupMember, error) {
+		if i := findGroupMember(members, member.MemberType, member.Member); i >= 0 {
+			return nil, fmt.Errorf("%s %q is already a member of group %s; import it with the ID %s/%s", member.MemberType, member.Member, groupID, groupID, member.Member)
+		}
+		return append(members, member), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", groupID, member.Member))
+	return resourceGroupMemberRead(ctx, d, meta)
+}
+
+func resourceGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupID, memberName, err := parseGroupMemberID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var group Group
+	err = client.get(ctx, fmt.Sprintf("/api/v2/groups/%s", groupID), &group)
+	if isHTTPStatus(err, http.StatusNotFound) {
+		// The group was deleted, and its members with it
+		log.Printf("[INFO] Group %s no longer exists; removing member %s from state", groupID, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read group: %w", err))
+	}
+
+	i := findGroupMember(group.Members, d.Get("member_type").(string), memberName)
+	if i < 0 {
+		// Removed outside Terraform
+		d.SetId("")
+		return nil
+	}
+	member := group.Members[i]
+
+	d.Set("group_id", group.GroupID)
+	d.Set("member_type", member.MemberType)
+
+	// The ID holds the resolved name; keep an ID or differently cased reference
+	if d.Get("member").(string) == "" {
+		d.Set("member", member.Member)
+	}
+	d.Set("sequence", member.Sequence)
+	d.Set("roles", member.Roles)
+
+	return nil
+}
+
+func resourceGroupMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupID, memberName, err := parseGroupMemberID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	member := expandGroupMember(d)
+	member.Member = memberName
+
+	err = updateGroupMembers(ctx, client, groupID, func(members []GroupMember) ([]GroupMember, error) {
+		i := findGroupMember(members, member.MemberType, member.Member)
+		if i < 0 {
+			return nil, fmt.Errorf("%s %q is no longer a member of group %s", member.MemberType, member.Member, groupID)
+		}
+		members[i] = member
+		return members, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGroupMemberRead(ctx, d, meta)
+}
+
+func resourceGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupID, memberName, err := parseGroupMemberID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	memberType := d.Get("member_type").(string)
+
+	err = updateGroupMembers(ctx, client, groupID, func(members []GroupMember) ([]GroupMember, error) {
+		i := findGroupMember(members, memberType, memberName)
+		if i < 0 {
+			return members, nil
+		}
+		return append(members[:i], members[i+1:]...), nil
+	})
+	if err != nil && !isHTTPStatus(err, http.StatusNotFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceGroupMemberCustomizeDiff checks that the member exists
+func resourceGroupMemberCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*Client)
+	if !ok || !d.NewValueKnown("member_type") || !d.NewValueKnown("member") {
+		return nil
+	}
+
+	return newGroupMemberResolver(client, true).check(ctx, d.Get("member_type").(string), d.Get("member").(string))
+}
+
+// resourceGroupMemberImport accepts IDs of the form group_id/member
+func resourceGroupMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client)
+
+	groupID, memberName, err := parseGroupMemberID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	var group Group
+	err = client.get(ctx, fmt.Sprintf("/api/v2/groups/%s", groupID), &group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read group: %w", err)
+	}
+
+	var matches []GroupMember
+	for _, member := range group.Members {
+		if member.Member == memberName {
+			matches = append(matches, member)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%q is not a member of group %s", memberName, groupID)
+	case 1:
+	default:
+		return nil, fmt.Errorf("group %s has both a user and a group named %q, which can't be told apart on import", groupID, memberName)
+	}
+
+	d.Set("group_id", group.GroupID)
+	d.Set("member_type", matches[0].MemberType)
+	d.Set("member", matches[0].Member)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateGroupMembers applies update to the current members of a group and writes
+// the group back, holding the group's lock so that concurrent member resources
+// don't overwrite each other
+func updateGroupMembers(ctx context.Context, client *Client, groupID string, update func([]GroupMember) ([]GroupMember, error)) error {
+	unlock := client.lock(groupLockKey(groupID))
+	defer unlock()
+
+	var group Group
+	err := client.get(ctx, fmt.Sprintf("/api/v2/groups/%s", groupID), &group)
+	if err != nil {
+		return fmt.Errorf("failed to read group: %w", err)
+	}
+
+	if group.Dynamic {
+		return fmt.Errorf("group %s is dynamic; its members come from its dynamic_rules", groupID)
+	}
+
+	members, err := update(group.Members)
+	if err != nil {
+		return err
+	}
+	group.Members = members
+
+	request := groupUpdateRequest(group)
+	if requestJSON, jsonErr := json.Marshal(request); jsonErr == nil {
+		log.Printf("[DEBUG] Updating members of group %s: %s", groupID, requestJSON)
+	}
+
+	err = client.put(ctx, fmt.Sprintf("/api/v2/groups/%s", groupID), request, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update group members: %w", err)
+	}
+	return nil
+}
+
+// expandGroupMember converts Terraform data to a GroupMember struct
+func expandGroupMember(d *schema.ResourceData) GroupMember {
+	return GroupMember{
+		MemberType: normalizeGroupMemberType(d.Get("member_type").(string)),
+		Member:     d.Get("member").(string),
+		Sequence:   d.Get("sequence").(int),
+		Roles:      expandStringSlice(d.Get("roles").([]interface{})),
+	}
+}
+
+// findGroupMember returns the index of a member, or -1. An empty memberType
+// matches members of either type.
+func findGroupMember(members []GroupMember, memberType, memberName string) int {
+	for i, member := range members {
+		if member.Member == memberName && (memberType == "" || strings.EqualFold(member.MemberType, memberType)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseGroupMemberID splits a group_id/member ID
+func parseGroupMemberID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected ID %q, expected group_id/member", id)
+	}
+	if _, err := strconv.Atoi(parts[0]); err != nil {
+		return "", "", fmt.Errorf("unexpected ID %q, group_id must be numeric", id)
+	}
+	return parts[0], parts[1], nil
+}
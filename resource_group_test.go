@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testGroupMember(name string, sequence int) map[string]interface{} {
+	return map[string]interface{}{
+		"member_type": "User",
+		"member":      name,
+		"sequence":    sequence,
+	}
+}
+
+func testGroup(members ...GroupMember) Group {
+	return Group{
+		GroupID:   7,
+		GroupName: "Infrastructure",
+		Members:   members,
+	}
+}
+
+func TestResourceGroupReadMembersRemovedOutsideTerraform(t *testing.T) {
+	server := newTestAPIServer(t, testGroup())
+	d := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{
+		"group_name": "Infrastructure",
+		"members":    []interface{}{testGroupMember("jdoe", 1)},
+	})
+	d.SetId("7")
+
+	if diags := resourceGroupRead(context.Background(), d, NewClient("key", server.URL)); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	if members := d.Get("members").([]interface{}); len(members) != 0 {
+		t.Errorf("members = %v, want none after they were removed in AlertOps", members)
+	}
+}
+
+func TestResourceGroupReadMembersAddedOutsideTerraform(t *testing.T) {
+	server := newTestAPIServer(t, testGroup(GroupMember{MemberType: "User", Member: "jdoe", Sequence: 1}))
+	d := schema.TestResourceDataRaw(t, resourceGroup().Schema, map[string]interface{}{
+		"group_name": "Infrastructure",
+	})
+	d.SetId("7")
+
+	if diags := resourceGroupRead(context.Background(), d, NewClient("key", server.URL)); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	members := d.Get("members").([]interface{})
+	if len(members) != 1 {
+		t.Fatalf("members has %d entries, want the 1 added in AlertOps", len(members))
+	}
+	if member := members[0].(map[string]interface{})["member"]; member != "jdoe" {
+		t.Errorf("member = %v, want jdoe", member)
+	}
+}
+
+func TestResourceGroupUpdateClearsMembers(t *testing.T) {
+	server := newTestAPIServer(t, testGroup(GroupMember{MemberType: "User", Member: "jdoe", Sequence: 1}))
+
+	// Prior state has a member that the configuration no longer lists
+	r := resourceGroup()
+	state := &terraform.InstanceState{
+		ID: "7",
+		Attributes: map[string]string{
+			"id":                    "7",
+			"group_id":              "7",
+			"group_name":            "Infrastructure",
+			"dynamic":               "false",
+			"deletion_policy":       "delete",
+			"members.#":             "1",
+			"members.0.member_type": "User",
+			"members.0.member":      "jdoe",
+			"members.0.sequence":    "1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"group_name": "Infrastructure",
+	})
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, config, nil, nil, false)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("data failed: %v", err)
+	}
+
+	if diags := resourceGroupUpdate(context.Background(), d, NewClient("key", server.URL)); diags.HasError() {
+		t.Fatalf("update failed: %v", diags)
+	}
+	for _, key := range []string{"members", "contact_methods", "topics", "attributes"} {
+		value, ok := server.lastPut[key].([]interface{})
+		if !ok || len(value) != 0 {
+			t.Errorf("PUT %s = %#v, want an empty array", key, server.lastPut[key])
+		}
+	}
+}
+
+func TestResourceGroupMemberReadGroupDeleted(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	d := schema.TestResourceDataRaw(t, resourceGroupMember().Schema, map[string]interface{}{
+		"group_id":    7,
+		"member_type": "User",
+		"member":      "jdoe",
+	})
+	d.SetId("7/jdoe")
+
+	if diags := resourceGroupMemberRead(context.Background(), d, NewClient("key", server.URL)); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("ID = %q, want the member removed from state with its group", d.Id())
+	}
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceHolidayCalendar manages a named set of holidays, listed explicitly or
+// generated from the holiday rules bundled with the provider. Schedules refer to
+// it through holiday_calendar_id.
+func resourceHolidayCalendar() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHolidayCalendarCreate,
+		ReadContext:   resourceHolidayCalendarRead,
+		UpdateContext: resourceHolidayCalendarUpdate,
+		DeleteContext: resourceHolidayCalendarDelete,
+		CustomizeDiff: resourceHolidayCalendarCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"holiday_calendar_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The unique identifier for the holiday calendar",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the holiday calendar",
+			},
+			"generate": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Public holidays of a country to include, from the rules bundled with the provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"country": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: fmt.Sprintf("ISO 3166-1 code of the country. Valid values: %v", HolidayCountries),
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := val.(string)
+								for _, country := range HolidayCountries {
+									if v == country {
+										return
+									}
+								}
+								errs = append(errs, fmt.Errorf("%q must be one of %v, got: %q", key, HolidayCountries, v))
+								return
+							},
+						},
+						"years": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Years to generate holidays for",
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validateIntBetween(minHolidayYear, maxHolidayYear),
+							},
+						},
+					},
+				},
+			},
+			"holiday": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Holidays to include. A holiday on the same date as a generated one replaces it",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Date (YYYY-MM-DD)",
+							ValidateFunc: validateScheduleDate,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the holiday",
+						},
+					},
+				},
+			},
+			"exclude_dates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Dates (YYYY-MM-DD) of generated holidays to leave out",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateScheduleDate,
+				},
+			},
+			"holidays": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All holidays in the calendar, in date order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date (YYYY-MM-DD)",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the holiday",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceHolidayCalendarCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	calendar, err := expandHolidayCalendar(d.Get)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if requestJSON, jsonErr := json.Marshal(calendar); jsonErr == nil {
+		log.Printf("[DEBUG] Creating holiday calendar: %s", requestJSON)
+	}
+
+	var created HolidayCalendar
+	err = client.post(ctx, "/api/v2/holiday_calendars", calendar, &created)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create holiday calendar: %w", err))
+	}
+
+	d.SetId(strconv.Itoa(created.HolidayCalendarID))
+	return resourceHolidayCalendarRead(ctx, d, meta)
+}
+
+func resourceHolidayCalendarRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	var calendar HolidayCalendar
+	err := client.get(ctx, holidayCalendarPath(d.Id()), &calendar)
+	if isHTTPStatus(err, http.StatusNotFound) {
+		log.Printf("[INFO] Holiday calendar %s no longer exists; removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read holiday calendar: %w", err))
+	}
+
+	holidays := calendar.Holidays
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date < holidays[j].Date
+	})
+
+	d.Set("holiday_calendar_id", calendar.HolidayCalendarID)
+	d.Set("name", calendar.Name)
+	d.Set("holidays", flattenHolidays(holidays))
+
+	return nil
+}
+
+func resourceHolidayCalendarUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	calendar, err := expandHolidayCalendar(d.Get)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	calendar.HolidayCalendarID = d.Get("holiday_calendar_id").(int)
+	if requestJSON, jsonErr := json.Marshal(calendar); jsonErr == nil {
+		log.Printf("[DEBUG] Updating holiday calendar %s: %s", d.Id(), requestJSON)
+	}
+
+	err = client.put(ctx, holidayCalendarPath(d.Id()), calendar, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update holiday calendar: %w", err))
+	}
+
+	return resourceHolidayCalendarRead(ctx, d, meta)
+}
+
+func resourceHolidayCalendarDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	err := client.delete(ctx, holidayCalendarPath(d.Id()))
+	if err != nil && !isHTTPStatus(err, http.StatusNotFound) {
+		return diag.FromErr(fmt.Errorf("failed to delete holiday calendar: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceHolidayCalendarCustomizeDiff plans holidays from generate, holiday and
+// exclude_dates, so that plans list every date in the calendar and changes made
+// outside Terraform show up as a diff
+func resourceHolidayCalendarCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"generate", "holiday", "exclude_dates"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("holidays")
+		}
+	}
+
+	calendar, err := expandHolidayCalendar(d.Get)
+	if err != nil {
+		return err
+	}
+	return d.SetNew("holidays", flattenHolidays(calendar.Holidays))
+}
+
+// expandHolidayCalendar converts Terraform data to a HolidayCalendar struct. The
+// generated holidays come first, then the excluded dates are removed, then the
+// holidays listed explicitly are added or replace generated ones.
+func expandHolidayCalendar(get func(string) interface{}) (HolidayCalendar, error) {
+	calendar := HolidayCalendar{Name: get("name").(string)}
+
+	byDate := make(map[string]Holiday)
+	for _, g := range get("generate").([]interface{}) {
+		generate, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, year := range generate["years"].([]interface{}) {
+			holidays, err := generateHolidays(generate["country"].(string), year.(int))
+			if err != nil {
+				return calendar, fmt.Errorf("generate: %w", err)
+			}
+			for _, holiday := range holidays {
+				// Keep the first name when countries share a holiday
+				if _, exists := byDate[holiday.Date]; !exists {
+					byDate[holiday.Date] = holiday
+				}
+			}
+		}
+	}
+
+	for _, date := range get("exclude_dates").([]interface{}) {
+		delete(byDate, date.(string))
+	}
+
+	explicit := make(map[string]bool)
+	for _, h := range get("holiday").([]interface{}) {
+		holiday, ok := h.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		date := holiday["date"].(string)
+		if explicit[date] {
+			return calendar, fmt.Errorf("holiday: date %s is listed more than once", date)
+		}
+		explicit[date] = true
+		byDate[date] = Holiday{Date: date, Name: holiday["name"].(string)}
+	}
+
+	calendar.Holidays = make([]Holiday, 0, len(byDate))
+	for _, holiday := range byDate {
+		calendar.Holidays = append(calendar.Holidays, holiday)
+	}
+	sort.Slice(calendar.Holidays, func(i, j int) bool {
+		return calendar.Holidays[i].Date < calendar.Holidays[j].Date
+	})
+	return calendar, nil
+}
+
+// flattenHolidays converts Holiday structs to Terraform data
+func flattenHolidays(holidays []Holiday) []interface{} {
+	result := make([]interface{}, len(holidays))
+	for i, holiday := range holidays {
+		result[i] = map[string]interface{}{
+			"date": holiday.Date,
+			"name": holiday.Name,
+		}
+	}
+	return result
+}
+
+// holidayCalendarPath returns the API path of a holiday calendar
+func holidayCalendarPath(id string) string {
+	return fmt.Sprintf("/api/v2/holiday_calendars/%s", id)
+}
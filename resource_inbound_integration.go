@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +17,7 @@ func resourceInboundIntegration() *schema.Resource {
 		ReadContext:   resourceInboundIntegrationRead,
 		UpdateContext: resourceInboundIntegrationUpdate,
 		DeleteContext: resourceInboundIntegrationDelete,
+		CustomizeDiff: resourceInboundIntegrationCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"inbound_integration_id": {
@@ -30,7 +33,8 @@ func resourceInboundIntegration() *schema.Resource {
 			"type": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The type of inbound integration (e.g., API, Email, Chat, etc.)",
+				ForceNew:    true,
+				Description: "The type of inbound integration (e.g., API, Email, Chat, etc.). Changing the type recreates the integration",
 			},
 			"sequence": {
 				Type:        schema.TypeInt,
@@ -171,13 +175,6 @@ func resourceInboundIntegration() *schema.Resource {
 							Description: "Email alert tags configuration",
 							Elem:        getEmailAlertTagsSchema(),
 						},
-						"delaying_or_grouping": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Email delaying and grouping rules",
-							Elem:        getDelayingOrGroupingSchema(),
-						},
 						"filters_to_match_incoming_emails": {
 							Type:        schema.TypeList,
 							Optional:    true,
@@ -192,12 +189,6 @@ func resourceInboundIntegration() *schema.Resource {
 							Description: "Email escalation policy override settings",
 							Elem:        getEscalationPolicyOverrideSchema(),
 						},
-						"dynamic_recipient_groups": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							Description: "Email dynamic recipient groups",
-							Elem:        getDynamicRecipientGroupSchema(),
-						},
 					},
 				},
 			},
@@ -240,6 +231,24 @@ func resourceInboundIntegration() *schema.Resource {
 					},
 				},
 			},
+			"integration_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Inbound endpoint URL that monitoring tools post alerts to",
+			},
+			"integration_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Integration key/token used to authenticate inbound alerts",
+			},
+			"mail_box_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Full email address of the integration mailbox",
+			},
 		},
 	}
 }
@@ -523,14 +532,74 @@ func getDelayingOrGroupingSchema() *schema.Resource {
 	return &schema.Resource{Schema: map[string]*schema.Schema{}}
 }
 
+// Helper function to get JSON/form field filters schema
 func getFiltersSchema() *schema.Resource {
-	// TODO: Implement this complex schema
-	return &schema.Resource{Schema: map[string]*schema.Schema{}}
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"add_all_filter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Filters that must all match",
+				Elem:        getFilterSetSchema(),
+			},
+			"add_any_filter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Filters of which at least one must match",
+				Elem:        getFilterSetSchema(),
+			},
+		},
+	}
 }
 
-func getEscalationPolicyOverrideSchema() *schema.Resource {
-	// TODO: Implement this complex schema
-	return &schema.Resource{Schema: map[string]*schema.Schema{}}
+// Helper function to get filter set schema
+func getFilterSetSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Filters in this set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "Filter ID assigned by AlertOps",
+						},
+						"condition": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: "Filter condition",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "JSON or form field path",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Condition type (e.g., Exists, NotEmpty)",
+									},
+								},
+							},
+						},
+						"not": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Negate the filter",
+						},
+					},
+				},
+			},
+		},
+	}
 }
 
 func getDynamicRecipientGroupSchema() *schema.Resource {
@@ -538,244 +607,1901 @@ func getDynamicRecipientGroupSchema() *schema.Resource {
 	return &schema.Resource{Schema: map[string]*schema.Schema{}}
 }
 
-func getEmailMappingSchema() *schema.Resource {
-	// TODO: Implement this complex schema
-	return &schema.Resource{Schema: map[string]*schema.Schema{}}
-}
-
-func getEmailAlertTagsSchema() *schema.Resource {
-	// TODO: Implement this complex schema
-	return &schema.Resource{Schema: map[string]*schema.Schema{}}
+// Helper function to get escalation policy override schema
+func getEscalationPolicyOverrideSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"based_on_time_of_day": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Override the escalation policy during a time window",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"week": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Days of the week the override applies to",
+							Elem:        getDaysOfWeekSchema(),
+						},
+						"start_time": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Start of the override window",
+							Elem:        getInboundIntegrationTimeSchema(),
+						},
+						"end_time": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "End of the override window",
+							Elem:        getInboundIntegrationTimeSchema(),
+						},
+						"escalation_policy_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the escalation policy to use",
+						},
+						"escalation_policy_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the escalation policy to use",
+						},
+					},
+				},
+			},
+			"based_on_source_data": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Override the escalation policy when source data matches a condition",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Source data condition",
+							Elem:        getSourceDataConditionSchema(),
+						},
+						"escalation_policy_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the escalation policy to use",
+						},
+						"escalation_policy_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the escalation policy to use",
+						},
+					},
+				},
+			},
+		},
+	}
 }
 
-func getEmailFiltersSchema() *schema.Resource {
-	// TODO: Implement this complex schema
-	return &schema.Resource{Schema: map[string]*schema.Schema{}}
+// Helper function to get days of week schema
+func getDaysOfWeekSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"sun": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Include Sunday",
+			},
+			"mon": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Include Monday",
+			},
+			"tue": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Include Tuesday",
+			},
+			"wed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Include Wednesday",
+			},
+			"thu": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Include Thursday",
+			},
+			"fri": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Include Friday",
+			},
+			"sat": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Include Saturday",
+			},
+		},
+	}
 }
 
-func getChatURLMappingSchema() *schema.Resource {
-	// TODO: Implement this complex schema
-	return &schema.Resource{Schema: map[string]*schema.Schema{}}
+// Helper function to get inbound integration time schema
+func getInboundIntegrationTimeSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hour": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Hour (0-23)",
+			},
+			"minute": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Minute (0-59)",
+			},
+		},
+	}
 }
 
-// CRUD OPERATIONS - Basic implementations
-
-func resourceInboundIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Client)
-
-	inboundIntegration := InboundIntegration{
-		InboundIntegrationName: d.Get("inbound_integration_name").(string),
-		Type:                   d.Get("type").(string),
-		Enabled:                d.Get("enabled").(bool),
+// Helper function to get source data condition schema
+func getSourceDataConditionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"field_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Source data field name",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Condition type",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value to compare against",
+			},
+		},
 	}
+}
 
-	if v, ok := d.GetOk("sequence"); ok {
-		inboundIntegration.Sequence = v.(int)
-	}
-	if v, ok := d.GetOk("escalation_policy"); ok {
-		inboundIntegration.EscalationPolicy = v.(string)
-	}
-	if v, ok := d.GetOk("recipient_groups"); ok {
-		inboundIntegration.RecipientGroups = expandStringSlice(v.([]interface{}))
-	}
-	if v, ok := d.GetOk("recipient_users"); ok {
-		inboundIntegration.RecipientUsers = expandStringSlice(v.([]interface{}))
-	}
-	if v, ok := d.GetOk("inbound_template_id"); ok {
-		inboundIntegration.InboundTemplateID = v.(int)
-	}
-	if v, ok := d.GetOk("mail_box"); ok {
-		inboundIntegration.MailBox = v.(string)
+// Helper function to get chat URL mapping schema
+func getChatURLMappingSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Source field",
+			},
+			"source_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Source name field",
+			},
+			"static": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the source is static",
+			},
+			"source_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Source value",
+			},
+		},
 	}
+}
 
-	// Handle nested structures
-	if v, ok := d.GetOk("bridge"); ok {
-		inboundIntegration.Bridge = expandBridge(v.([]interface{}))
-	}
-	if v, ok := d.GetOk("api_settings"); ok {
-		inboundIntegration.APISettings = expandAPISettings(v.([]interface{}))
-	}
-	if v, ok := d.GetOk("heartbeat_settings"); ok {
-		inboundIntegration.HeartbeatSettings = expandHeartbeatSettings(v.([]interface{}))
+// Helper function to get email field schema. Email fields are extracted from the
+// message text found between start_tag and end_tag.
+func getEmailFieldSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"field_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Email field to extract from (e.g., Subject, Body)",
+			},
+			"start_tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Text marking the start of the value",
+			},
+			"end_tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Text marking the end of the value",
+			},
+		},
 	}
+}
 
-	// Create inbound integration via API
-	var result InboundIntegration
-	err := client.post(ctx, "/api/v2/integrations/inbound", inboundIntegration, &result)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating inbound integration: %v", err))
+// Helper function to get a single-item list of email field schema
+func getEmailFieldListSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem:        getEmailFieldSchema(),
 	}
-
-	d.SetId(strconv.Itoa(result.InboundIntegrationID))
-
-	return resourceInboundIntegrationRead(ctx, d, meta)
 }
 
-func resourceInboundIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Client)
-
+// Helper function to get email mapping schema
+func getEmailMappingSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"every_incoming_email_will_open_an_alert": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether every incoming email opens a new alert",
+			},
+			"source_name":            getEmailFieldListSchema("Source name field"),
+			"source_identifier":      getEmailFieldListSchema("Source identifier field"),
+			"long_text":              getEmailFieldListSchema("Long text field"),
+			"short_text":             getEmailFieldListSchema("Short text field"),
+			"source_url":             getEmailFieldListSchema("Source URL field"),
+			"assignee_mail_official": getEmailFieldListSchema("Assignee official email field"),
+			"recipient_user":         getEmailFieldListSchema("Recipient user field"),
+			"recipient_groups":       getEmailFieldListSchema("Recipient groups field"),
+			"topic":                  getEmailFieldListSchema("Topic field"),
+			"open_alert_when": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Condition for opening alerts",
+				Elem:        getConditionSchema(),
+			},
+			"close_alert_when": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Condition for closing alerts",
+				Elem:        getSimpleConditionSchema(),
+			},
+			"update_alert_when": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Condition for updating alerts",
+				Elem:        getSimpleConditionSchema(),
+			},
+			"ignore_duplicates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether duplicate emails are ignored",
+			},
+			"custom_alert_fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Custom alert fields",
+				Elem:        getEmailCustomAlertFieldSchema(),
+			},
+			"long_message_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Long message text",
+			},
+			"short_message_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Short message text",
+			},
+			"sample_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Sample email data",
+			},
+		},
+	}
+}
+
+// Helper function to get email custom alert field schema
+func getEmailCustomAlertFieldSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"attribute_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Attribute name",
+			},
+			"attribute_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Attribute value",
+			},
+			"required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the field is required",
+			},
+			"attribute_data_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Attribute data type",
+			},
+			"start_tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Text marking the start of the value",
+			},
+			"end_tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Text marking the end of the value",
+			},
+		},
+	}
+}
+
+// Helper function to get email alert tags schema
+func getEmailAlertTagsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"business_service": getEmailFieldListSchema("Business service tag"),
+			"component_type":   getEmailFieldListSchema("Component type tag"),
+			"component_name":   getEmailFieldListSchema("Component name tag"),
+			"data_center":      getEmailFieldListSchema("Data center tag"),
+			"environment":      getEmailFieldListSchema("Environment tag"),
+			"problem_type":     getEmailFieldListSchema("Problem type tag"),
+		},
+	}
+}
+
+// Helper function to get email filters schema. Filters in each list are evaluated
+// in order: "and" joins a filter to the previous one with AND instead of OR, and
+// "not" negates the filter.
+func getEmailFiltersSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"subject_filters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Filters on the email subject",
+				Elem:        getEmailTextFilterSchema(),
+			},
+			"body_filters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Filters on the email body",
+				Elem:        getEmailTextFilterSchema(),
+			},
+			"sender_or_recipient_filters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Filters on the email sender or recipients",
+				Elem:        getEmailRecipientFilterSchema(),
+			},
+			"priority_filters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Filters on the email priority",
+				Elem:        getEmailPriorityFilterSchema(),
+			},
+		},
+	}
+}
+
+// Helper function to get email subject/body filter schema
+func getEmailTextFilterSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"filter_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Filter ID assigned by AlertOps",
+			},
+			"condition": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Filter condition",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Condition type (e.g., Contains, Equals)",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value to compare against",
+						},
+					},
+				},
+			},
+			"and": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Combine with the previous filter using AND instead of OR",
+			},
+			"not": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Negate the filter",
+			},
+		},
+	}
+}
+
+// Helper function to get email sender/recipient filter schema
+func getEmailRecipientFilterSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"filter_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Filter ID assigned by AlertOps",
+			},
+			"recipient_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Sender or recipient name",
+			},
+			"recipient_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Sender or recipient email address",
+			},
+			"recipient_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Which address to match (e.g., From, To, Cc)",
+			},
+			"and": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Combine with the previous filter using AND instead of OR",
+			},
+			"not": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Negate the filter",
+			},
+		},
+	}
+}
+
+// Helper function to get email priority filter schema
+func getEmailPriorityFilterSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"filter_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Filter ID assigned by AlertOps",
+			},
+			"priority": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Email priority (e.g., High, Normal, Low)",
+			},
+			"and": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Combine with the previous filter using AND instead of OR",
+			},
+			"not": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Negate the filter",
+			},
+		},
+	}
+}
+
+// resourceInboundIntegrationCustomizeDiff validates settings blocks against the integration type
+func resourceInboundIntegrationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	integrationType := d.Get("type").(string)
+
+	if v, ok := d.GetOk("chat_settings"); ok && len(v.([]interface{})) > 0 && !isChatInboundIntegrationType(integrationType) {
+		return fmt.Errorf("chat_settings is only supported for chat integrations (%v), got type %q", ChatInboundIntegrationTypes, integrationType)
+	}
+
+	allowedBlocks, ok := lookupInboundIntegrationTypeBlocks(integrationType)
+	if !ok {
+		// Unknown types are passed through so that new AlertOps integration
+		// types can be used before the provider knows about them
+		log.Printf("[WARN] Unknown inbound integration type %q, skipping settings validation", integrationType)
+	} else {
+		for _, block := range InboundIntegrationSettingsBlocks {
+			if _, set := d.GetOk(block); !set {
+				continue
+			}
+			if !containsString(allowedBlocks, block) {
+				return fmt.Errorf("%s is not supported for inbound integrations of type %q (supported: %v)", block, integrationType, allowedBlocks)
+			}
+		}
+	}
+
+	if containsStringFold(EmailInboundIntegrationTypes, integrationType) && d.NewValueKnown("mail_box") {
+		if _, set := d.GetOk("mail_box"); !set {
+			return fmt.Errorf("mail_box is required for inbound integrations of type %q", integrationType)
+		}
+	}
+
+	if v, set := d.GetOk("inbound_template_id"); set && d.NewValueKnown("inbound_template_id") {
+		if client, ok := meta.(*Client); ok {
+			if err := validateInboundTemplateID(ctx, client, v.(int), integrationType); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isChatInboundIntegrationType reports whether the integration type is chat-based
+func isChatInboundIntegrationType(integrationType string) bool {
+	for _, chatType := range ChatInboundIntegrationTypes {
+		if strings.EqualFold(integrationType, chatType) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupInboundIntegrationTypeBlocks returns the settings blocks accepted by an integration type
+func lookupInboundIntegrationTypeBlocks(integrationType string) ([]string, bool) {
+	for knownType, blocks := range InboundIntegrationTypeBlocks {
+		if strings.EqualFold(knownType, integrationType) {
+			return blocks, true
+		}
+	}
+	return nil, false
+}
+
+// validateInboundTemplateID checks the template ID against the AlertOps template catalog
+func validateInboundTemplateID(ctx context.Context, client *Client, templateID int, integrationType string) error {
+	var templates InboundTemplateListResponse
+	if err := client.getCached(ctx, "/api/v2/integrations/inbound/templates", &templates); err != nil {
+		// The catalog is only used for early feedback; the API still validates on apply
+		log.Printf("[WARN] Unable to load inbound template catalog, skipping inbound_template_id validation: %v", err)
+		return nil
+	}
+
+	for _, template := range templates.Templates {
+		if template.InboundTemplateID != templateID {
+			continue
+		}
+		if template.Type != "" && !strings.EqualFold(template.Type, integrationType) {
+			return fmt.Errorf("inbound_template_id %d (%s) is a %q template and cannot be used with type %q", templateID, template.TemplateName, template.Type, integrationType)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("inbound_template_id %d was not found in the AlertOps inbound template catalog", templateID)
+}
+
+// containsString reports whether the slice contains the value
+func containsString(slice []string, value string) bool {
+	for _, v := range slice {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// containsStringFold reports whether the slice contains the value, ignoring case
+func containsStringFold(slice []string, value string) bool {
+	for _, v := range slice {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// CRUD OPERATIONS - Basic implementations
+
+func resourceInboundIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	inboundIntegration := InboundIntegration{
+		InboundIntegrationName: d.Get("inbound_integration_name").(string),
+		Type:                   d.Get("type").(string),
+		Enabled:                d.Get("enabled").(bool),
+	}
+
+	if v, ok := d.GetOk("sequence"); ok {
+		inboundIntegration.Sequence = v.(int)
+	}
+	if v, ok := d.GetOk("escalation_policy"); ok {
+		inboundIntegration.EscalationPolicy = v.(string)
+	}
+	if v, ok := d.GetOk("recipient_groups"); ok {
+		inboundIntegration.RecipientGroups = expandStringSlice(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("recipient_users"); ok {
+		inboundIntegration.RecipientUsers = expandStringSlice(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("inbound_template_id"); ok {
+		inboundIntegration.InboundTemplateID = v.(int)
+	}
+	if v, ok := d.GetOk("mail_box"); ok {
+		inboundIntegration.MailBox = v.(string)
+	}
+
+	// Handle nested structures
+	if v, ok := d.GetOk("bridge"); ok {
+		inboundIntegration.Bridge = expandBridge(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("api_settings"); ok {
+		inboundIntegration.APISettings = expandAPISettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("email_settings"); ok {
+		inboundIntegration.EmailSettings = expandEmailSettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("chat_settings"); ok {
+		inboundIntegration.ChatSettings = expandChatSettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("heartbeat_settings"); ok {
+		inboundIntegration.HeartbeatSettings = expandHeartbeatSettings(v.([]interface{}))
+	}
+
+	// Create inbound integration via API
+	var result InboundIntegration
+	err := client.post(ctx, "/api/v2/integrations/inbound", inboundIntegration, &result)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating inbound integration: %v", err))
+	}
+
+	d.SetId(strconv.Itoa(result.InboundIntegrationID))
+
+	return resourceInboundIntegrationRead(ctx, d, meta)
+}
+
+func resourceInboundIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
 	inboundIntegrationID := d.Id()
 	
 	var inboundIntegration InboundIntegration
-	err := client.get(ctx, fmt.Sprintf("/api/v2/integrations/inbound/%s", inboundIntegrationID), &inboundIntegration)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error reading inbound integration: %v", err))
+	err := client.get(ctx, fmt.Sprintf("/api/v2/integrations/inbound/%s", inboundIntegrationID), &inboundIntegration)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading inbound integration: %v", err))
+	}
+
+	d.Set("inbound_integration_id", inboundIntegration.InboundIntegrationID)
+	d.Set("inbound_integration_name", inboundIntegration.InboundIntegrationName)
+	d.Set("type", inboundIntegration.Type)
+	d.Set("sequence", inboundIntegration.Sequence)
+	d.Set("enabled", inboundIntegration.Enabled)
+	d.Set("escalation_policy", inboundIntegration.EscalationPolicy)
+	d.Set("recipient_groups", inboundIntegration.RecipientGroups)
+	d.Set("recipient_users", inboundIntegration.RecipientUsers)
+	d.Set("inbound_template_id", inboundIntegration.InboundTemplateID)
+	d.Set("mail_box", inboundIntegration.MailBox)
+	d.Set("integration_url", inboundIntegration.IntegrationURL)
+	d.Set("integration_key", inboundIntegration.IntegrationKey)
+	d.Set("mail_box_address", inboundIntegration.MailBoxAddress)
+
+	// Set nested structures
+	d.Set("bridge", flattenBridge(inboundIntegration.Bridge))
+	d.Set("api_settings", flattenAPISettings(inboundIntegration.APISettings))
+	d.Set("email_settings", flattenEmailSettings(inboundIntegration.EmailSettings))
+	d.Set("chat_settings", flattenChatSettings(inboundIntegration.ChatSettings))
+	d.Set("heartbeat_settings", flattenHeartbeatSettings(inboundIntegration.HeartbeatSettings))
+
+	return nil
+}
+
+func resourceInboundIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	inboundIntegrationID := d.Id()
+	inboundIntegration := InboundIntegration{
+		InboundIntegrationID:   d.Get("inbound_integration_id").(int),
+		InboundIntegrationName: d.Get("inbound_integration_name").(string),
+		Type:                   d.Get("type").(string),
+		Enabled:                d.Get("enabled").(bool),
+	}
+
+	if v, ok := d.GetOk("sequence"); ok {
+		inboundIntegration.Sequence = v.(int)
+	}
+	if v, ok := d.GetOk("escalation_policy"); ok {
+		inboundIntegration.EscalationPolicy = v.(string)
+	}
+	if v, ok := d.GetOk("recipient_groups"); ok {
+		inboundIntegration.RecipientGroups = expandStringSlice(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("recipient_users"); ok {
+		inboundIntegration.RecipientUsers = expandStringSlice(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("inbound_template_id"); ok {
+		inboundIntegration.InboundTemplateID = v.(int)
+	}
+	if v, ok := d.GetOk("mail_box"); ok {
+		inboundIntegration.MailBox = v.(string)
+	}
+
+	// Handle nested structures
+	if v, ok := d.GetOk("bridge"); ok {
+		inboundIntegration.Bridge = expandBridge(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("api_settings"); ok {
+		inboundIntegration.APISettings = expandAPISettings(v.([]interface{}))
+	}
+	// Removed settings blocks are sent empty so that AlertOps clears them
+	if d.HasChange("email_settings") {
+		inboundIntegration.EmailSettings = expandEmailSettings(d.Get("email_settings").([]interface{}))
+		if inboundIntegration.EmailSettings == nil {
+			inboundIntegration.EmailSettings = &InboundIntegrationEmailSettings{}
+		}
+	} else if v, ok := d.GetOk("email_settings"); ok {
+		inboundIntegration.EmailSettings = expandEmailSettings(v.([]interface{}))
+	}
+	if d.HasChange("chat_settings") {
+		inboundIntegration.ChatSettings = expandChatSettings(d.Get("chat_settings").([]interface{}))
+		if inboundIntegration.ChatSettings == nil {
+			inboundIntegration.ChatSettings = &InboundIntegrationChatSettings{}
+		}
+	} else if v, ok := d.GetOk("chat_settings"); ok {
+		inboundIntegration.ChatSettings = expandChatSettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("heartbeat_settings"); ok {
+		inboundIntegration.HeartbeatSettings = expandHeartbeatSettings(v.([]interface{}))
+	}
+
+	// Update inbound integration via API
+	err := client.put(ctx, fmt.Sprintf("/api/v2/integrations/inbound/%s", inboundIntegrationID), inboundIntegration, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating inbound integration: %v", err))
+	}
+
+	return resourceInboundIntegrationRead(ctx, d, meta)
+}
+
+func resourceInboundIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	inboundIntegrationID := d.Id()
+	err := client.delete(ctx, fmt.Sprintf("/api/v2/integrations/inbound/%s", inboundIntegrationID))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting inbound integration: %v", err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dataSourceInboundIntegration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInboundIntegrationRead,
+		Schema: map[string]*schema.Schema{
+			"inbound_integration_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier for the inbound integration",
+			},
+			"inbound_integration_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the inbound integration",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of inbound integration",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the integration is enabled",
+			},
+			"escalation_policy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The escalation policy for this integration",
+			},
+			"recipient_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of recipient groups",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"recipient_users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of recipient users",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mail_box": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Mailbox for email integrations",
+			},
+			"integration_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Inbound endpoint URL that monitoring tools post alerts to",
+			},
+			"integration_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Integration key/token used to authenticate inbound alerts",
+			},
+			"mail_box_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Full email address of the integration mailbox",
+			},
+		},
+	}
+}
+
+func dataSourceInboundIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	var inboundIntegration InboundIntegration
+
+	// If inbound_integration_id is provided, fetch by ID
+	if id, ok := d.GetOk("inbound_integration_id"); ok {
+		err := client.get(ctx, fmt.Sprintf("/api/v2/integrations/inbound/%d", id.(int)), &inboundIntegration)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading inbound integration: %v", err))
+		}
+	} else {
+		// Otherwise, search by inbound_integration_name and read the match by ID,
+		// since the list endpoint does not return endpoint details
+		name := d.Get("inbound_integration_name").(string)
+		if name == "" {
+			return diag.Errorf("one of inbound_integration_id or inbound_integration_name must be set")
+		}
+
+		var listResponse InboundIntegrationListResponse
+		err := client.get(ctx, "/api/v2/integrations/inbound", &listResponse)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing inbound integrations: %v", err))
+		}
+
+		found := false
+		for _, integration := range listResponse.InboundIntegrations {
+			if integration.InboundIntegrationName == name {
+				err := client.get(ctx, fmt.Sprintf("/api/v2/integrations/inbound/%d", integration.InboundIntegrationID), &inboundIntegration)
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error reading inbound integration: %v", err))
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return diag.Errorf("inbound integration %q not found", name)
+		}
+	}
+
+	d.SetId(strconv.Itoa(inboundIntegration.InboundIntegrationID))
+	d.Set("inbound_integration_id", inboundIntegration.InboundIntegrationID)
+	d.Set("inbound_integration_name", inboundIntegration.InboundIntegrationName)
+	d.Set("type", inboundIntegration.Type)
+	d.Set("enabled", inboundIntegration.Enabled)
+	d.Set("escalation_policy", inboundIntegration.EscalationPolicy)
+	d.Set("recipient_groups", inboundIntegration.RecipientGroups)
+	d.Set("recipient_users", inboundIntegration.RecipientUsers)
+	d.Set("mail_box", inboundIntegration.MailBox)
+	d.Set("integration_url", inboundIntegration.IntegrationURL)
+	d.Set("integration_key", inboundIntegration.IntegrationKey)
+	d.Set("mail_box_address", inboundIntegration.MailBoxAddress)
+
+	return nil
+}
+
+// HELPER FUNCTIONS FOR EXPANDING AND FLATTENING
+
+// expandBridge converts Terraform data to Bridge struct
+func expandBridge(bridgeData []interface{}) *InboundIntegrationBridge {
+	if len(bridgeData) == 0 {
+		return nil
+	}
+
+	if bridgeData[0] == nil {
+		return nil
+	}
+
+	bridgeMap := bridgeData[0].(map[string]interface{})
+	bridge := &InboundIntegrationBridge{}
+
+	if v, ok := bridgeMap["telephone_number"]; ok && v.(string) != "" {
+		bridge.TelephoneNumber = v.(string)
+	}
+	if v, ok := bridgeMap["access_code"]; ok && v.(string) != "" {
+		bridge.AccessCode = v.(string)
+	}
+
+	return bridge
+}
+
+// flattenBridge converts Bridge struct to Terraform data
+func flattenBridge(bridge *InboundIntegrationBridge) []map[string]interface{} {
+	if bridge == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"telephone_number": bridge.TelephoneNumber,
+			"access_code":      bridge.AccessCode,
+		},
+	}
+}
+
+// expandAPISettings converts Terraform data to APISettings struct (basic implementation)
+func expandAPISettings(apiSettingsData []interface{}) *InboundIntegrationAPISettings {
+	if len(apiSettingsData) == 0 {
+		return nil
+	}
+
+	if apiSettingsData[0] == nil {
+		return nil
+	}
+
+	apiSettingsMap := apiSettingsData[0].(map[string]interface{})
+	apiSettings := &InboundIntegrationAPISettings{}
+
+	if v, ok := apiSettingsMap["is_bidirection"]; ok {
+		apiSettings.IsBidirection = v.(bool)
+	}
+
+	// TODO: Implement nested structures like url_mapping, alert_tags, etc.
+	// For now, only handling basic boolean field
+
+	return apiSettings
+}
+
+// flattenAPISettings converts APISettings struct to Terraform data (basic implementation)
+func flattenAPISettings(apiSettings *InboundIntegrationAPISettings) []map[string]interface{} {
+	if apiSettings == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"is_bidirection": apiSettings.IsBidirection,
+			// TODO: Add nested structures
+		},
+	}
+}
+
+// expandURLMapping converts Terraform data to URLMapping struct
+func expandURLMapping(urlMappingData []interface{}) *InboundIntegrationURLMapping {
+	if len(urlMappingData) == 0 {
+		return nil
+	}
+
+	if urlMappingData[0] == nil {
+		return nil
+	}
+
+	urlMappingMap := urlMappingData[0].(map[string]interface{})
+	urlMapping := &InboundIntegrationURLMapping{
+		Method:         urlMappingMap["method"].(string),
+		Content:        urlMappingMap["content"].(string),
+		Source:         urlMappingMap["source"].(string),
+		SourceName:     urlMappingMap["source_name"].(string),
+		Static:         urlMappingMap["static"].(bool),
+		SourceValue:    urlMappingMap["source_value"].(string),
+		SourceID:       urlMappingMap["source_id"].(string),
+		SourceURL:      urlMappingMap["source_url"].(string),
+		Severity:       urlMappingMap["severity"].(string),
+		SourceStatus:   urlMappingMap["source_status"].(string),
+		Assignee:       urlMappingMap["assignee"].(string),
+		LongText:       urlMappingMap["long_text"].(string),
+		ShortText:      urlMappingMap["short_text"].(string),
+		Subject:        urlMappingMap["subject"].(string),
+		RecipientUser:  urlMappingMap["recipient_user"].(string),
+		RecipientGroup: urlMappingMap["recipient_group"].(string),
+		Topic:          urlMappingMap["topic"].(string),
+		SampleData:     urlMappingMap["sample_data"].(string),
+	}
+
+	if v, ok := urlMappingMap["open_alert_when"]; ok && v != nil {
+		urlMapping.OpenAlertWhen = expandInboundIntegrationCondition(v.([]interface{}))
+	}
+	if v, ok := urlMappingMap["close_alert_when"]; ok && v != nil {
+		urlMapping.CloseAlertWhen = expandInboundIntegrationSimpleCondition(v.([]interface{}))
+	}
+	if v, ok := urlMappingMap["update_alert_when"]; ok && v != nil {
+		urlMapping.UpdateAlertWhen = expandInboundIntegrationSimpleCondition(v.([]interface{}))
+	}
+	if v, ok := urlMappingMap["custom_alert_fields"]; ok && v != nil {
+		for _, fieldData := range v.([]interface{}) {
+			fieldMap := fieldData.(map[string]interface{})
+			urlMapping.CustomAlertFields = append(urlMapping.CustomAlertFields, InboundIntegrationCustomAlertField{
+				AttributeName:  fieldMap["attribute_name"].(string),
+				AttributeValue: fieldMap["attribute_value"].(string),
+				Required:       fieldMap["required"].(bool),
+			})
+		}
+	}
+	if v, ok := urlMappingMap["attachments"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		attachmentsMap := v[0].(map[string]interface{})
+		urlMapping.Attachments = &InboundIntegrationAttachments{
+			BasePath:     attachmentsMap["base_path"].(string),
+			URL:          attachmentsMap["url"].(string),
+			FileName:     attachmentsMap["file_name"].(string),
+			IsLink:       attachmentsMap["is_link"].(bool),
+			IsCollection: attachmentsMap["is_collection"].(bool),
+		}
+	}
+
+	return urlMapping
+}
+
+// expandAlertTags converts Terraform data to AlertTags struct
+func expandAlertTags(alertTagsData []interface{}) *InboundIntegrationAlertTags {
+	if len(alertTagsData) == 0 {
+		return nil
+	}
+
+	if alertTagsData[0] == nil {
+		return nil
+	}
+
+	alertTagsMap := alertTagsData[0].(map[string]interface{})
+	return &InboundIntegrationAlertTags{
+		BusinessService: alertTagsMap["business_service"].(string),
+		ComponentType:   alertTagsMap["component_type"].(string),
+		ComponentName:   alertTagsMap["component_name"].(string),
+		DataCenter:      alertTagsMap["data_center"].(string),
+		Environment:     alertTagsMap["environment"].(string),
+		ProblemType:     alertTagsMap["problem_type"].(string),
+	}
+}
+
+// expandFilters converts Terraform data to Filters struct
+func expandFilters(filtersData []interface{}) *InboundIntegrationFilters {
+	if len(filtersData) == 0 {
+		return nil
+	}
+
+	if filtersData[0] == nil {
+		return nil
+	}
+
+	filtersMap := filtersData[0].(map[string]interface{})
+	filters := &InboundIntegrationFilters{}
+
+	if v, ok := filtersMap["add_all_filter"]; ok && v != nil {
+		filters.AddAllFilter = expandFilterSet(v.([]interface{}))
+	}
+	if v, ok := filtersMap["add_any_filter"]; ok && v != nil {
+		filters.AddAnyFilter = expandFilterSet(v.([]interface{}))
+	}
+
+	return filters
+}
+
+// expandFilterSet converts Terraform data to FilterSet struct
+func expandFilterSet(filterSetData []interface{}) *InboundIntegrationFilterSet {
+	if len(filterSetData) == 0 {
+		return nil
+	}
+
+	if filterSetData[0] == nil {
+		return &InboundIntegrationFilterSet{}
+	}
+
+	filterSetMap := filterSetData[0].(map[string]interface{})
+	filterSet := &InboundIntegrationFilterSet{}
+
+	for _, filterData := range filterSetMap["filters"].([]interface{}) {
+		filterMap := filterData.(map[string]interface{})
+		filter := InboundIntegrationFilter{
+			FilterID: filterMap["filter_id"].(int),
+			Not:      filterMap["not"].(bool),
+		}
+		if v, ok := filterMap["condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			conditionMap := v[0].(map[string]interface{})
+			filter.Condition = &InboundIntegrationFilterCondition{
+				FieldName: conditionMap["field_name"].(string),
+				Type:      conditionMap["type"].(string),
+			}
+		}
+		filterSet.Filters = append(filterSet.Filters, filter)
+	}
+
+	return filterSet
+}
+
+// expandEmailSettings converts Terraform data to EmailSettings struct
+func expandEmailSettings(emailSettingsData []interface{}) *InboundIntegrationEmailSettings {
+	if len(emailSettingsData) == 0 {
+		return nil
+	}
+
+	if emailSettingsData[0] == nil {
+		return nil
+	}
+
+	emailSettingsMap := emailSettingsData[0].(map[string]interface{})
+	emailSettings := &InboundIntegrationEmailSettings{}
+
+	if v, ok := emailSettingsMap["email_mapping"]; ok && v != nil {
+		emailSettings.EmailMapping = expandEmailMapping(v.([]interface{}))
+	}
+	if v, ok := emailSettingsMap["alert_tags"]; ok && v != nil {
+		emailSettings.AlertTags = expandEmailAlertTags(v.([]interface{}))
+	}
+	if v, ok := emailSettingsMap["filters_to_match_incoming_emails"]; ok && v != nil {
+		emailSettings.FiltersToMatchIncomingEmails = expandEmailFilters(v.([]interface{}))
+	}
+
+	return emailSettings
+}
+
+// flattenEmailSettings converts EmailSettings struct to Terraform data
+func flattenEmailSettings(emailSettings *InboundIntegrationEmailSettings) []map[string]interface{} {
+	// Cleared settings come back empty and are the same as no block
+	if emailSettings == nil || (emailSettings.EmailMapping == nil && emailSettings.AlertTags == nil && emailSettings.FiltersToMatchIncomingEmails == nil) {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"email_mapping":                    flattenEmailMapping(emailSettings.EmailMapping),
+			"alert_tags":                       flattenEmailAlertTags(emailSettings.AlertTags),
+			"filters_to_match_incoming_emails": flattenEmailFilters(emailSettings.FiltersToMatchIncomingEmails),
+		},
+	}
+}
+
+// expandEmailMapping converts Terraform data to EmailMapping struct
+func expandEmailMapping(emailMappingData []interface{}) *InboundIntegrationEmailMapping {
+	if len(emailMappingData) == 0 {
+		return nil
+	}
+
+	if emailMappingData[0] == nil {
+		return nil
+	}
+
+	emailMappingMap := emailMappingData[0].(map[string]interface{})
+	emailMapping := &InboundIntegrationEmailMapping{}
+
+	if v, ok := emailMappingMap["every_incoming_email_will_open_an_alert"]; ok {
+		emailMapping.EveryIncomingEmailWillOpenAnAlert = v.(bool)
+	}
+	if v, ok := emailMappingMap["source_name"]; ok && v != nil {
+		emailMapping.SourceName = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["source_identifier"]; ok && v != nil {
+		emailMapping.SourceIdentifier = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["open_alert_when"]; ok && v != nil {
+		emailMapping.OpenAlertWhen = expandInboundIntegrationCondition(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["close_alert_when"]; ok && v != nil {
+		emailMapping.CloseAlertWhen = expandInboundIntegrationSimpleCondition(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["update_alert_when"]; ok && v != nil {
+		emailMapping.UpdateAlertWhen = expandInboundIntegrationSimpleCondition(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["ignore_duplicates"]; ok {
+		emailMapping.IgnoreDuplicates = v.(bool)
+	}
+	if v, ok := emailMappingMap["long_text"]; ok && v != nil {
+		emailMapping.LongText = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["short_text"]; ok && v != nil {
+		emailMapping.ShortText = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["source_url"]; ok && v != nil {
+		emailMapping.SourceURL = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["assignee_mail_official"]; ok && v != nil {
+		emailMapping.AssigneeMailOfficial = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["recipient_user"]; ok && v != nil {
+		emailMapping.RecipientUser = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["recipient_groups"]; ok && v != nil {
+		emailMapping.RecipientGroups = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["topic"]; ok && v != nil {
+		emailMapping.Topic = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["custom_alert_fields"]; ok && v != nil {
+		emailMapping.CustomAlertFields = expandEmailCustomAlertFields(v.([]interface{}))
+	}
+	if v, ok := emailMappingMap["long_message_text"]; ok && v.(string) != "" {
+		emailMapping.LongMessageText = v.(string)
+	}
+	if v, ok := emailMappingMap["short_message_text"]; ok && v.(string) != "" {
+		emailMapping.ShortMessageText = v.(string)
+	}
+	if v, ok := emailMappingMap["sample_data"]; ok && v.(string) != "" {
+		emailMapping.SampleData = v.(string)
+	}
+
+	return emailMapping
+}
+
+// flattenEmailMapping converts EmailMapping struct to Terraform data
+func flattenEmailMapping(emailMapping *InboundIntegrationEmailMapping) []map[string]interface{} {
+	if emailMapping == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"every_incoming_email_will_open_an_alert": emailMapping.EveryIncomingEmailWillOpenAnAlert,
+			"source_name":                             flattenEmailField(emailMapping.SourceName),
+			"source_identifier":                       flattenEmailField(emailMapping.SourceIdentifier),
+			"open_alert_when":                         flattenInboundIntegrationCondition(emailMapping.OpenAlertWhen),
+			"close_alert_when":                        flattenInboundIntegrationSimpleCondition(emailMapping.CloseAlertWhen),
+			"update_alert_when":                       flattenInboundIntegrationSimpleCondition(emailMapping.UpdateAlertWhen),
+			"ignore_duplicates":                       emailMapping.IgnoreDuplicates,
+			"long_text":                               flattenEmailField(emailMapping.LongText),
+			"short_text":                              flattenEmailField(emailMapping.ShortText),
+			"source_url":                              flattenEmailField(emailMapping.SourceURL),
+			"assignee_mail_official":                  flattenEmailField(emailMapping.AssigneeMailOfficial),
+			"recipient_user":                          flattenEmailField(emailMapping.RecipientUser),
+			"recipient_groups":                        flattenEmailField(emailMapping.RecipientGroups),
+			"topic":                                   flattenEmailField(emailMapping.Topic),
+			"custom_alert_fields":                     flattenEmailCustomAlertFields(emailMapping.CustomAlertFields),
+			"long_message_text":                       emailMapping.LongMessageText,
+			"short_message_text":                      emailMapping.ShortMessageText,
+			"sample_data":                             emailMapping.SampleData,
+		},
+	}
+}
+
+// expandEmailField converts Terraform data to EmailField struct
+func expandEmailField(emailFieldData []interface{}) *InboundIntegrationEmailField {
+	if len(emailFieldData) == 0 {
+		return nil
+	}
+
+	if emailFieldData[0] == nil {
+		return nil
+	}
+
+	emailFieldMap := emailFieldData[0].(map[string]interface{})
+	emailField := &InboundIntegrationEmailField{}
+
+	if v, ok := emailFieldMap["field_name"]; ok && v.(string) != "" {
+		emailField.FieldName = v.(string)
+	}
+	if v, ok := emailFieldMap["start_tag"]; ok && v.(string) != "" {
+		emailField.StartTag = v.(string)
+	}
+	if v, ok := emailFieldMap["end_tag"]; ok && v.(string) != "" {
+		emailField.EndTag = v.(string)
+	}
+
+	return emailField
+}
+
+// flattenEmailField converts EmailField struct to Terraform data
+func flattenEmailField(emailField *InboundIntegrationEmailField) []map[string]interface{} {
+	if emailField == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"field_name": emailField.FieldName,
+			"start_tag":  emailField.StartTag,
+			"end_tag":    emailField.EndTag,
+		},
+	}
+}
+
+// expandInboundIntegrationCondition converts Terraform data to Condition struct
+func expandInboundIntegrationCondition(conditionData []interface{}) *InboundIntegrationCondition {
+	if len(conditionData) == 0 {
+		return nil
+	}
+
+	if conditionData[0] == nil {
+		return nil
+	}
+
+	conditionMap := conditionData[0].(map[string]interface{})
+	condition := &InboundIntegrationCondition{}
+
+	if v, ok := conditionMap["field_name"]; ok && v.(string) != "" {
+		condition.FieldName = v.(string)
+	}
+	if v, ok := conditionMap["type"]; ok && v.(string) != "" {
+		condition.Type = v.(string)
+	}
+	if v, ok := conditionMap["values"]; ok && v != nil {
+		condition.Values = expandStringSlice(v.([]interface{}))
+	}
+
+	return condition
+}
+
+// flattenInboundIntegrationCondition converts Condition struct to Terraform data
+func flattenInboundIntegrationCondition(condition *InboundIntegrationCondition) []map[string]interface{} {
+	if condition == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"field_name": condition.FieldName,
+			"type":       condition.Type,
+			"values":     condition.Values,
+		},
 	}
+}
 
-	d.Set("inbound_integration_id", inboundIntegration.InboundIntegrationID)
-	d.Set("inbound_integration_name", inboundIntegration.InboundIntegrationName)
-	d.Set("type", inboundIntegration.Type)
-	d.Set("sequence", inboundIntegration.Sequence)
-	d.Set("enabled", inboundIntegration.Enabled)
-	d.Set("escalation_policy", inboundIntegration.EscalationPolicy)
-	d.Set("recipient_groups", inboundIntegration.RecipientGroups)
-	d.Set("recipient_users", inboundIntegration.RecipientUsers)
-	d.Set("inbound_template_id", inboundIntegration.InboundTemplateID)
-	d.Set("mail_box", inboundIntegration.MailBox)
+// expandInboundIntegrationSimpleCondition converts Terraform data to SimpleCondition struct
+func expandInboundIntegrationSimpleCondition(conditionData []interface{}) *InboundIntegrationSimpleCondition {
+	if len(conditionData) == 0 {
+		return nil
+	}
 
-	// Set nested structures
-	d.Set("bridge", flattenBridge(inboundIntegration.Bridge))
-	d.Set("api_settings", flattenAPISettings(inboundIntegration.APISettings))
-	d.Set("heartbeat_settings", flattenHeartbeatSettings(inboundIntegration.HeartbeatSettings))
+	if conditionData[0] == nil {
+		return nil
+	}
 
-	return nil
+	conditionMap := conditionData[0].(map[string]interface{})
+	condition := &InboundIntegrationSimpleCondition{}
+
+	if v, ok := conditionMap["type"]; ok && v.(string) != "" {
+		condition.Type = v.(string)
+	}
+	if v, ok := conditionMap["values"]; ok && v != nil {
+		condition.Values = expandStringSlice(v.([]interface{}))
+	}
+
+	return condition
 }
 
-func resourceInboundIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Client)
+// flattenInboundIntegrationSimpleCondition converts SimpleCondition struct to Terraform data
+func flattenInboundIntegrationSimpleCondition(condition *InboundIntegrationSimpleCondition) []map[string]interface{} {
+	if condition == nil {
+		return nil
+	}
 
-	inboundIntegrationID := d.Id()
-	inboundIntegration := InboundIntegration{
-		InboundIntegrationID:   d.Get("inbound_integration_id").(int),
-		InboundIntegrationName: d.Get("inbound_integration_name").(string),
-		Type:                   d.Get("type").(string),
-		Enabled:                d.Get("enabled").(bool),
+	return []map[string]interface{}{
+		{
+			"type":   condition.Type,
+			"values": condition.Values,
+		},
 	}
+}
 
-	if v, ok := d.GetOk("sequence"); ok {
-		inboundIntegration.Sequence = v.(int)
+// expandEmailCustomAlertFields converts Terraform data to EmailCustomAlertField structs
+func expandEmailCustomAlertFields(fieldsData []interface{}) []InboundIntegrationEmailCustomAlertField {
+	if len(fieldsData) == 0 {
+		return nil
 	}
-	if v, ok := d.GetOk("escalation_policy"); ok {
-		inboundIntegration.EscalationPolicy = v.(string)
+
+	fields := make([]InboundIntegrationEmailCustomAlertField, len(fieldsData))
+	for i, fieldData := range fieldsData {
+		fieldMap := fieldData.(map[string]interface{})
+
+		if v, ok := fieldMap["attribute_name"]; ok && v.(string) != "" {
+			fields[i].AttributeName = v.(string)
+		}
+		if v, ok := fieldMap["attribute_value"]; ok && v.(string) != "" {
+			fields[i].AttributeValue = v.(string)
+		}
+		if v, ok := fieldMap["required"]; ok {
+			fields[i].Required = v.(bool)
+		}
+		if v, ok := fieldMap["attribute_data_type"]; ok && v.(string) != "" {
+			fields[i].AttributeDataType = v.(string)
+		}
+		if v, ok := fieldMap["start_tag"]; ok && v.(string) != "" {
+			fields[i].StartTag = v.(string)
+		}
+		if v, ok := fieldMap["end_tag"]; ok && v.(string) != "" {
+			fields[i].EndTag = v.(string)
+		}
 	}
-	if v, ok := d.GetOk("recipient_groups"); ok {
-		inboundIntegration.RecipientGroups = expandStringSlice(v.([]interface{}))
+	return fields
+}
+
+// flattenEmailCustomAlertFields converts EmailCustomAlertField structs to Terraform data
+func flattenEmailCustomAlertFields(fields []InboundIntegrationEmailCustomAlertField) []map[string]interface{} {
+	if len(fields) == 0 {
+		return nil
 	}
-	if v, ok := d.GetOk("recipient_users"); ok {
-		inboundIntegration.RecipientUsers = expandStringSlice(v.([]interface{}))
+
+	result := make([]map[string]interface{}, len(fields))
+	for i, field := range fields {
+		result[i] = map[string]interface{}{
+			"attribute_name":      field.AttributeName,
+			"attribute_value":     field.AttributeValue,
+			"required":            field.Required,
+			"attribute_data_type": field.AttributeDataType,
+			"start_tag":           field.StartTag,
+			"end_tag":             field.EndTag,
+		}
 	}
-	if v, ok := d.GetOk("inbound_template_id"); ok {
-		inboundIntegration.InboundTemplateID = v.(int)
+	return result
+}
+
+// expandEmailAlertTags converts Terraform data to EmailAlertTags struct
+func expandEmailAlertTags(alertTagsData []interface{}) *InboundIntegrationEmailAlertTags {
+	if len(alertTagsData) == 0 {
+		return nil
 	}
-	if v, ok := d.GetOk("mail_box"); ok {
-		inboundIntegration.MailBox = v.(string)
+
+	if alertTagsData[0] == nil {
+		return nil
 	}
 
-	// Handle nested structures
-	if v, ok := d.GetOk("bridge"); ok {
-		inboundIntegration.Bridge = expandBridge(v.([]interface{}))
+	alertTagsMap := alertTagsData[0].(map[string]interface{})
+	alertTags := &InboundIntegrationEmailAlertTags{}
+
+	if v, ok := alertTagsMap["business_service"]; ok && v != nil {
+		alertTags.BusinessService = expandEmailField(v.([]interface{}))
 	}
-	if v, ok := d.GetOk("api_settings"); ok {
-		inboundIntegration.APISettings = expandAPISettings(v.([]interface{}))
+	if v, ok := alertTagsMap["component_type"]; ok && v != nil {
+		alertTags.ComponentType = expandEmailField(v.([]interface{}))
 	}
-	if v, ok := d.GetOk("heartbeat_settings"); ok {
-		inboundIntegration.HeartbeatSettings = expandHeartbeatSettings(v.([]interface{}))
+	if v, ok := alertTagsMap["component_name"]; ok && v != nil {
+		alertTags.ComponentName = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := alertTagsMap["data_center"]; ok && v != nil {
+		alertTags.DataCenter = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := alertTagsMap["environment"]; ok && v != nil {
+		alertTags.Environment = expandEmailField(v.([]interface{}))
+	}
+	if v, ok := alertTagsMap["problem_type"]; ok && v != nil {
+		alertTags.ProblemType = expandEmailField(v.([]interface{}))
 	}
 
-	// Update inbound integration via API
-	err := client.put(ctx, fmt.Sprintf("/api/v2/integrations/inbound/%s", inboundIntegrationID), inboundIntegration, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating inbound integration: %v", err))
+	return alertTags
+}
+
+// flattenEmailAlertTags converts EmailAlertTags struct to Terraform data
+func flattenEmailAlertTags(alertTags *InboundIntegrationEmailAlertTags) []map[string]interface{} {
+	if alertTags == nil {
+		return nil
 	}
 
-	return resourceInboundIntegrationRead(ctx, d, meta)
+	return []map[string]interface{}{
+		{
+			"business_service": flattenEmailField(alertTags.BusinessService),
+			"component_type":   flattenEmailField(alertTags.ComponentType),
+			"component_name":   flattenEmailField(alertTags.ComponentName),
+			"data_center":      flattenEmailField(alertTags.DataCenter),
+			"environment":      flattenEmailField(alertTags.Environment),
+			"problem_type":     flattenEmailField(alertTags.ProblemType),
+		},
+	}
 }
 
-func resourceInboundIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Client)
+// expandEmailFilters converts Terraform data to EmailFilters struct
+func expandEmailFilters(filtersData []interface{}) *InboundIntegrationEmailFilters {
+	if len(filtersData) == 0 {
+		return nil
+	}
 
-	inboundIntegrationID := d.Id()
-	err := client.delete(ctx, fmt.Sprintf("/api/v2/integrations/inbound/%s", inboundIntegrationID))
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting inbound integration: %v", err))
+	if filtersData[0] == nil {
+		return nil
 	}
 
-	d.SetId("")
-	return nil
+	filtersMap := filtersData[0].(map[string]interface{})
+	filters := &InboundIntegrationEmailFilters{}
+
+	if v, ok := filtersMap["subject_filters"]; ok && v != nil {
+		for _, filterData := range v.([]interface{}) {
+			filterMap := filterData.(map[string]interface{})
+			filters.SubjectFilters = append(filters.SubjectFilters, InboundIntegrationEmailSubjectFilter{
+				FilterID:  filterMap["filter_id"].(int),
+				Condition: expandEmailFilterCondition(filterMap["condition"].([]interface{})),
+				And:       filterMap["and"].(bool),
+				Not:       filterMap["not"].(bool),
+			})
+		}
+	}
+	if v, ok := filtersMap["body_filters"]; ok && v != nil {
+		for _, filterData := range v.([]interface{}) {
+			filterMap := filterData.(map[string]interface{})
+			filters.BodyFilters = append(filters.BodyFilters, InboundIntegrationEmailBodyFilter{
+				FilterID:  filterMap["filter_id"].(int),
+				Condition: expandEmailFilterCondition(filterMap["condition"].([]interface{})),
+				And:       filterMap["and"].(bool),
+				Not:       filterMap["not"].(bool),
+			})
+		}
+	}
+	if v, ok := filtersMap["sender_or_recipient_filters"]; ok && v != nil {
+		for _, filterData := range v.([]interface{}) {
+			filterMap := filterData.(map[string]interface{})
+			filters.SenderOrRecipientFilters = append(filters.SenderOrRecipientFilters, InboundIntegrationEmailRecipientFilter{
+				FilterID:         filterMap["filter_id"].(int),
+				RecipientName:    filterMap["recipient_name"].(string),
+				RecipientAddress: filterMap["recipient_address"].(string),
+				RecipientType:    filterMap["recipient_type"].(string),
+				And:              filterMap["and"].(bool),
+				Not:              filterMap["not"].(bool),
+			})
+		}
+	}
+	if v, ok := filtersMap["priority_filters"]; ok && v != nil {
+		for _, filterData := range v.([]interface{}) {
+			filterMap := filterData.(map[string]interface{})
+			filters.PriorityFilters = append(filters.PriorityFilters, InboundIntegrationEmailPriorityFilter{
+				FilterID: filterMap["filter_id"].(int),
+				Priority: filterMap["priority"].(string),
+				And:      filterMap["and"].(bool),
+				Not:      filterMap["not"].(bool),
+			})
+		}
+	}
+
+	return filters
 }
 
-// HELPER FUNCTIONS FOR EXPANDING AND FLATTENING
+// flattenEmailFilters converts EmailFilters struct to Terraform data
+func flattenEmailFilters(filters *InboundIntegrationEmailFilters) []map[string]interface{} {
+	if filters == nil {
+		return nil
+	}
 
-// expandBridge converts Terraform data to Bridge struct
-func expandBridge(bridgeData []interface{}) *InboundIntegrationBridge {
-	if len(bridgeData) == 0 {
+	subjectFilters := make([]map[string]interface{}, len(filters.SubjectFilters))
+	for i, filter := range filters.SubjectFilters {
+		subjectFilters[i] = map[string]interface{}{
+			"filter_id": filter.FilterID,
+			"condition": flattenEmailFilterCondition(filter.Condition),
+			"and":       filter.And,
+			"not":       filter.Not,
+		}
+	}
+
+	bodyFilters := make([]map[string]interface{}, len(filters.BodyFilters))
+	for i, filter := range filters.BodyFilters {
+		bodyFilters[i] = map[string]interface{}{
+			"filter_id": filter.FilterID,
+			"condition": flattenEmailFilterCondition(filter.Condition),
+			"and":       filter.And,
+			"not":       filter.Not,
+		}
+	}
+
+	recipientFilters := make([]map[string]interface{}, len(filters.SenderOrRecipientFilters))
+	for i, filter := range filters.SenderOrRecipientFilters {
+		recipientFilters[i] = map[string]interface{}{
+			"filter_id":         filter.FilterID,
+			"recipient_name":    filter.RecipientName,
+			"recipient_address": filter.RecipientAddress,
+			"recipient_type":    filter.RecipientType,
+			"and":               filter.And,
+			"not":               filter.Not,
+		}
+	}
+
+	priorityFilters := make([]map[string]interface{}, len(filters.PriorityFilters))
+	for i, filter := range filters.PriorityFilters {
+		priorityFilters[i] = map[string]interface{}{
+			"filter_id": filter.FilterID,
+			"priority":  filter.Priority,
+			"and":       filter.And,
+			"not":       filter.Not,
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"subject_filters":             subjectFilters,
+			"body_filters":                bodyFilters,
+			"sender_or_recipient_filters": recipientFilters,
+			"priority_filters":            priorityFilters,
+		},
+	}
+}
+
+// expandEmailFilterCondition converts Terraform data to EmailFilterCondition struct
+func expandEmailFilterCondition(conditionData []interface{}) *InboundIntegrationEmailFilterCondition {
+	if len(conditionData) == 0 {
 		return nil
 	}
 
-	if bridgeData[0] == nil {
+	if conditionData[0] == nil {
 		return nil
 	}
 
-	bridgeMap := bridgeData[0].(map[string]interface{})
-	bridge := &InboundIntegrationBridge{}
+	conditionMap := conditionData[0].(map[string]interface{})
+	return &InboundIntegrationEmailFilterCondition{
+		Type:  conditionMap["type"].(string),
+		Value: conditionMap["value"].(string),
+	}
+}
 
-	if v, ok := bridgeMap["telephone_number"]; ok && v.(string) != "" {
-		bridge.TelephoneNumber = v.(string)
+// flattenEmailFilterCondition converts EmailFilterCondition struct to Terraform data
+func flattenEmailFilterCondition(condition *InboundIntegrationEmailFilterCondition) []map[string]interface{} {
+	if condition == nil {
+		return nil
 	}
-	if v, ok := bridgeMap["access_code"]; ok && v.(string) != "" {
-		bridge.AccessCode = v.(string)
+
+	return []map[string]interface{}{
+		{
+			"type":  condition.Type,
+			"value": condition.Value,
+		},
 	}
+}
 
-	return bridge
+// expandChatSettings converts Terraform data to ChatSettings struct
+func expandChatSettings(chatSettingsData []interface{}) *InboundIntegrationChatSettings {
+	if len(chatSettingsData) == 0 {
+		return nil
+	}
+
+	if chatSettingsData[0] == nil {
+		return nil
+	}
+
+	chatSettingsMap := chatSettingsData[0].(map[string]interface{})
+	chatSettings := &InboundIntegrationChatSettings{}
+
+	if v, ok := chatSettingsMap["url_mapping"]; ok && v != nil {
+		chatSettings.URLMapping = expandChatURLMapping(v.([]interface{}))
+	}
+	if v, ok := chatSettingsMap["escalation_policy_override"]; ok && v != nil {
+		chatSettings.EscalationPolicyOverride = expandEscalationPolicyOverride(v.([]interface{}))
+	}
+
+	return chatSettings
 }
 
-// flattenBridge converts Bridge struct to Terraform data
-func flattenBridge(bridge *InboundIntegrationBridge) []map[string]interface{} {
-	if bridge == nil {
+// flattenChatSettings converts ChatSettings struct to Terraform data
+func flattenChatSettings(chatSettings *InboundIntegrationChatSettings) []map[string]interface{} {
+	// Cleared settings come back empty and are the same as no block
+	if chatSettings == nil || (chatSettings.URLMapping == nil && chatSettings.EscalationPolicyOverride == nil) {
 		return nil
 	}
 
 	return []map[string]interface{}{
 		{
-			"telephone_number": bridge.TelephoneNumber,
-			"access_code":      bridge.AccessCode,
+			"url_mapping":                flattenChatURLMapping(chatSettings.URLMapping),
+			"escalation_policy_override": flattenEscalationPolicyOverride(chatSettings.EscalationPolicyOverride),
 		},
 	}
 }
 
-// expandAPISettings converts Terraform data to APISettings struct (basic implementation)
-func expandAPISettings(apiSettingsData []interface{}) *InboundIntegrationAPISettings {
-	if len(apiSettingsData) == 0 {
+// expandChatURLMapping converts Terraform data to ChatURLMapping struct
+func expandChatURLMapping(urlMappingData []interface{}) *InboundIntegrationChatURLMapping {
+	if len(urlMappingData) == 0 {
 		return nil
 	}
 
-	if apiSettingsData[0] == nil {
+	if urlMappingData[0] == nil {
 		return nil
 	}
 
-	apiSettingsMap := apiSettingsData[0].(map[string]interface{})
-	apiSettings := &InboundIntegrationAPISettings{}
+	urlMappingMap := urlMappingData[0].(map[string]interface{})
+	urlMapping := &InboundIntegrationChatURLMapping{}
 
-	if v, ok := apiSettingsMap["is_bidirection"]; ok {
-		apiSettings.IsBidirection = v.(bool)
+	if v, ok := urlMappingMap["source"]; ok && v.(string) != "" {
+		urlMapping.Source = v.(string)
+	}
+	if v, ok := urlMappingMap["source_name"]; ok && v.(string) != "" {
+		urlMapping.SourceName = v.(string)
+	}
+	if v, ok := urlMappingMap["static"]; ok {
+		urlMapping.Static = v.(bool)
+	}
+	if v, ok := urlMappingMap["source_value"]; ok && v.(string) != "" {
+		urlMapping.SourceValue = v.(string)
 	}
 
-	// TODO: Implement nested structures like url_mapping, alert_tags, etc.
-	// For now, only handling basic boolean field
+	return urlMapping
+}
 
-	return apiSettings
+// flattenChatURLMapping converts ChatURLMapping struct to Terraform data
+func flattenChatURLMapping(urlMapping *InboundIntegrationChatURLMapping) []map[string]interface{} {
+	if urlMapping == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"source":       urlMapping.Source,
+			"source_name":  urlMapping.SourceName,
+			"static":       urlMapping.Static,
+			"source_value": urlMapping.SourceValue,
+		},
+	}
 }
 
-// flattenAPISettings converts APISettings struct to Terraform data (basic implementation)
-func flattenAPISettings(apiSettings *InboundIntegrationAPISettings) []map[string]interface{} {
-	if apiSettings == nil {
+// expandEscalationPolicyOverride converts Terraform data to EscalationPolicyOverride struct
+func expandEscalationPolicyOverride(overrideData []interface{}) *InboundIntegrationEscalationPolicyOverride {
+	if len(overrideData) == 0 {
+		return nil
+	}
+
+	if overrideData[0] == nil {
+		return nil
+	}
+
+	overrideMap := overrideData[0].(map[string]interface{})
+	override := &InboundIntegrationEscalationPolicyOverride{}
+
+	if v, ok := overrideMap["based_on_time_of_day"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		timeOfDayMap := v[0].(map[string]interface{})
+		override.BasedOnTimeOfDay = &InboundIntegrationEscalationPolicyTimeOfDay{
+			Week:                 expandDaysOfWeek(timeOfDayMap["week"].([]interface{})),
+			StartTime:            expandInboundIntegrationTime(timeOfDayMap["start_time"].([]interface{})),
+			EndTime:              expandInboundIntegrationTime(timeOfDayMap["end_time"].([]interface{})),
+			EscalationPolicyID:   timeOfDayMap["escalation_policy_id"].(string),
+			EscalationPolicyName: timeOfDayMap["escalation_policy_name"].(string),
+		}
+	}
+
+	if v, ok := overrideMap["based_on_source_data"].([]interface{}); ok {
+		for _, sourceData := range v {
+			sourceDataMap := sourceData.(map[string]interface{})
+			override.BasedOnSourceData = append(override.BasedOnSourceData, InboundIntegrationEscalationPolicySourceData{
+				Condition:            expandSourceDataCondition(sourceDataMap["condition"].([]interface{})),
+				EscalationPolicyID:   sourceDataMap["escalation_policy_id"].(string),
+				EscalationPolicyName: sourceDataMap["escalation_policy_name"].(string),
+			})
+		}
+	}
+
+	return override
+}
+
+// flattenEscalationPolicyOverride converts EscalationPolicyOverride struct to Terraform data
+func flattenEscalationPolicyOverride(override *InboundIntegrationEscalationPolicyOverride) []map[string]interface{} {
+	if override == nil {
 		return nil
 	}
 
+	var timeOfDay []map[string]interface{}
+	if override.BasedOnTimeOfDay != nil {
+		timeOfDay = []map[string]interface{}{
+			{
+				"week":                   flattenDaysOfWeek(override.BasedOnTimeOfDay.Week),
+				"start_time":             flattenInboundIntegrationTime(override.BasedOnTimeOfDay.StartTime),
+				"end_time":               flattenInboundIntegrationTime(override.BasedOnTimeOfDay.EndTime),
+				"escalation_policy_id":   override.BasedOnTimeOfDay.EscalationPolicyID,
+				"escalation_policy_name": override.BasedOnTimeOfDay.EscalationPolicyName,
+			},
+		}
+	}
+
+	sourceData := make([]map[string]interface{}, len(override.BasedOnSourceData))
+	for i, data := range override.BasedOnSourceData {
+		sourceData[i] = map[string]interface{}{
+			"condition":              flattenSourceDataCondition(data.Condition),
+			"escalation_policy_id":   data.EscalationPolicyID,
+			"escalation_policy_name": data.EscalationPolicyName,
+		}
+	}
+
 	return []map[string]interface{}{
 		{
-			"is_bidirection": apiSettings.IsBidirection,
-			// TODO: Add nested structures
+			"based_on_time_of_day": timeOfDay,
+			"based_on_source_data": sourceData,
+		},
+	}
+}
+
+// expandDaysOfWeek converts Terraform data to DaysOfWeek struct
+func expandDaysOfWeek(daysData []interface{}) *InboundIntegrationDaysOfWeek {
+	if len(daysData) == 0 {
+		return nil
+	}
+
+	if daysData[0] == nil {
+		return nil
+	}
+
+	daysMap := daysData[0].(map[string]interface{})
+	return &InboundIntegrationDaysOfWeek{
+		Sun: daysMap["sun"].(bool),
+		Mon: daysMap["mon"].(bool),
+		Tue: daysMap["tue"].(bool),
+		Wed: daysMap["wed"].(bool),
+		Thu: daysMap["thu"].(bool),
+		Fri: daysMap["fri"].(bool),
+		Sat: daysMap["sat"].(bool),
+	}
+}
+
+// flattenDaysOfWeek converts DaysOfWeek struct to Terraform data
+func flattenDaysOfWeek(days *InboundIntegrationDaysOfWeek) []map[string]interface{} {
+	if days == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"sun": days.Sun,
+			"mon": days.Mon,
+			"tue": days.Tue,
+			"wed": days.Wed,
+			"thu": days.Thu,
+			"fri": days.Fri,
+			"sat": days.Sat,
+		},
+	}
+}
+
+// expandInboundIntegrationTime converts Terraform data to Time struct
+func expandInboundIntegrationTime(timeData []interface{}) *InboundIntegrationTime {
+	if len(timeData) == 0 {
+		return nil
+	}
+
+	if timeData[0] == nil {
+		return nil
+	}
+
+	timeMap := timeData[0].(map[string]interface{})
+	return &InboundIntegrationTime{
+		Hour:   timeMap["hour"].(int),
+		Minute: timeMap["minute"].(int),
+	}
+}
+
+// flattenInboundIntegrationTime converts Time struct to Terraform data
+func flattenInboundIntegrationTime(t *InboundIntegrationTime) []map[string]interface{} {
+	if t == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"hour":   t.Hour,
+			"minute": t.Minute,
+		},
+	}
+}
+
+// expandSourceDataCondition converts Terraform data to SourceDataCondition struct
+func expandSourceDataCondition(conditionData []interface{}) *InboundIntegrationSourceDataCondition {
+	if len(conditionData) == 0 {
+		return nil
+	}
+
+	if conditionData[0] == nil {
+		return nil
+	}
+
+	conditionMap := conditionData[0].(map[string]interface{})
+	return &InboundIntegrationSourceDataCondition{
+		FieldName: conditionMap["field_name"].(string),
+		Type:      conditionMap["type"].(string),
+		Value:     conditionMap["value"].(string),
+	}
+}
+
+// flattenSourceDataCondition converts SourceDataCondition struct to Terraform data
+func flattenSourceDataCondition(condition *InboundIntegrationSourceDataCondition) []map[string]interface{} {
+	if condition == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"field_name": condition.FieldName,
+			"type":       condition.Type,
+			"value":      condition.Value,
 		},
 	}
 }
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceInboundIntegrationUpdateClearsEmailSettings(t *testing.T) {
+	server := newTestAPIServer(t, InboundIntegration{
+		InboundIntegrationID:   5,
+		InboundIntegrationName: "Mailbox",
+		Type:                   "Email",
+		MailBox:                "alerts",
+		EmailSettings:          &InboundIntegrationEmailSettings{},
+	})
+
+	// Prior state has email_settings that the configuration no longer has
+	r := resourceInboundIntegration()
+	state := &terraform.InstanceState{
+		ID: "5",
+		Attributes: map[string]string{
+			"id":                               "5",
+			"inbound_integration_id":           "5",
+			"inbound_integration_name":         "Mailbox",
+			"type":                             "Email",
+			"mail_box":                         "alerts",
+			"enabled":                          "true",
+			"email_settings.#":                 "1",
+			"email_settings.0.email_mapping.#": "1",
+			"email_settings.0.email_mapping.0.every_incoming_email_will_open_an_alert": "true",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"inbound_integration_name": "Mailbox",
+		"type":                     "Email",
+		"mail_box":                 "alerts",
+	})
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, config, nil, nil, false)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("data failed: %v", err)
+	}
+
+	if diags := resourceInboundIntegrationUpdate(context.Background(), d, NewClient("key", server.URL)); diags.HasError() {
+		t.Fatalf("update failed: %v", diags)
+	}
+	if settings, ok := server.lastPut["email_settings"].(map[string]interface{}); !ok || len(settings) != 0 {
+		t.Errorf("PUT email_settings = %#v, want an empty object", server.lastPut["email_settings"])
+	}
+	if _, ok := server.lastPut["chat_settings"]; ok {
+		t.Errorf("PUT chat_settings = %#v, want it left out", server.lastPut["chat_settings"])
+	}
+	if settings := d.Get("email_settings").([]interface{}); len(settings) != 0 {
+		t.Errorf("email_settings = %v after reading empty settings back, want none", settings)
+	}
+}
@@ -4,7 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +21,7 @@ func resourceSchedule() *schema.Resource {
 		ReadContext:   resourceScheduleRead,
 		UpdateContext: resourceScheduleUpdate,
 		DeleteContext: resourceScheduleDelete,
+		CustomizeDiff: resourceScheduleCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -37,9 +43,16 @@ func resourceSchedule() *schema.Resource {
 				Description: "The name of the schedule",
 			},
 			"schedule_type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The type of schedule",
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The type of schedule: 'Fixed' or 'Rotating'",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !containsString(ScheduleTypes, v) {
+						errs = append(errs, fmt.Errorf("%q must be one of %v, got: %q", key, ScheduleTypes, v))
+					}
+					return
+				},
 			},
 			"continuous": {
 				Type:        schema.TypeBool,
@@ -48,9 +61,11 @@ func resourceSchedule() *schema.Resource {
 				Description: "Whether the schedule is continuous",
 			},
 			"time_zone": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The timezone for the schedule",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The timezone for the schedule. Accepts the AlertOps name, a Windows time zone ID or an IANA zone such as America/New_York",
+				ValidateFunc:     validateTimeZone,
+				DiffSuppressFunc: suppressEquivalentTimeZone,
 			},
 			"color": {
 				Type:        schema.TypeString,
@@ -65,19 +80,22 @@ func resourceSchedule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"date": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Start date (YYYY-MM-DD format)",
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Start date (YYYY-MM-DD format)",
+							ValidateFunc: validateScheduleDate,
 						},
 						"hour": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Start hour (0-23)",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Start hour (0-23)",
+							ValidateFunc: validateIntBetween(0, 23),
 						},
 						"minute": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Start minute (0-59)",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Start minute (0-59)",
+							ValidateFunc: validateIntBetween(0, 59),
 						},
 					},
 				},
@@ -90,32 +108,37 @@ func resourceSchedule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"date": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "End date (YYYY-MM-DD format)",
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "End date (YYYY-MM-DD format)",
+							ValidateFunc: validateScheduleDate,
 						},
 						"hour": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "End hour (0-23)",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "End hour (0-23)",
+							ValidateFunc: validateIntBetween(0, 23),
 						},
 						"minute": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "End minute (0-59)",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "End minute (0-59)",
+							ValidateFunc: validateIntBetween(0, 59),
 						},
 					},
 				},
 			},
 			"start_weekday": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Starting weekday",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Starting weekday (Sun, Mon, ... Sat)",
+				ValidateFunc: validateScheduleWeekday,
 			},
 			"end_weekday": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Ending weekday",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Ending weekday (Sun, Mon, ... Sat)",
+				ValidateFunc: validateScheduleWeekday,
 			},
 			"schedule_weekdays": {
 				Type:        schema.TypeList,
@@ -170,9 +193,16 @@ func resourceSchedule() *schema.Resource {
 				},
 			},
 			"rotate_frequency": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Rotation frequency (daily, weekly, monthly)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Rotation frequency (daily, weekly, monthly). The matching rotate_* block is required",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !containsString(RotateFrequencies, v) {
+						errs = append(errs, fmt.Errorf("%q must be one of %v, got: %q", key, RotateFrequencies, v))
+					}
+					return
+				},
 			},
 			"rotate_daily": {
 				Type:        schema.TypeList,
@@ -182,9 +212,10 @@ func resourceSchedule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rotate_x_users": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Number of users to rotate",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Number of users to rotate",
+							ValidateFunc: validateIntAtLeast(1),
 						},
 						"rotate_at_time": {
 							Type:        schema.TypeList,
@@ -194,22 +225,25 @@ func resourceSchedule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"hour": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: "Hour (0-23)",
+										Type:         schema.TypeInt,
+										Required:     true,
+										Description:  "Hour (0-23)",
+										ValidateFunc: validateIntBetween(0, 23),
 									},
 									"minute": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: "Minute (0-59)",
+										Type:         schema.TypeInt,
+										Required:     true,
+										Description:  "Minute (0-59)",
+										ValidateFunc: validateIntBetween(0, 59),
 									},
 								},
 							},
 						},
 						"every_x_days": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Rotate every X days",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Rotate every X days",
+							ValidateFunc: validateIntAtLeast(1),
 						},
 					},
 				},
@@ -222,9 +256,10 @@ func resourceSchedule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rotate_x_users": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Number of users to rotate",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Number of users to rotate",
+							ValidateFunc: validateIntAtLeast(1),
 						},
 						"rotate_at_time": {
 							Type:        schema.TypeList,
@@ -234,27 +269,31 @@ func resourceSchedule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"hour": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: "Hour (0-23)",
+										Type:         schema.TypeInt,
+										Required:     true,
+										Description:  "Hour (0-23)",
+										ValidateFunc: validateIntBetween(0, 23),
 									},
 									"minute": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: "Minute (0-59)",
+										Type:         schema.TypeInt,
+										Required:     true,
+										Description:  "Minute (0-59)",
+										ValidateFunc: validateIntBetween(0, 59),
 									},
 								},
 							},
 						},
 						"every_x_weeks": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Rotate every X weeks",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Rotate every X weeks",
+							ValidateFunc: validateIntAtLeast(1),
 						},
 						"rotate_at_day_of_week": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Day of week to rotate",
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Day of week to rotate (Sun, Mon, ... Sat)",
+							ValidateFunc: validateScheduleWeekday,
 						},
 					},
 				},
@@ -267,9 +306,10 @@ func resourceSchedule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rotate_x_users": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Number of users to rotate",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Number of users to rotate",
+							ValidateFunc: validateIntAtLeast(1),
 						},
 						"rotate_at_time": {
 							Type:        schema.TypeList,
@@ -279,22 +319,25 @@ func resourceSchedule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"hour": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: "Hour (0-23)",
+										Type:         schema.TypeInt,
+										Required:     true,
+										Description:  "Hour (0-23)",
+										ValidateFunc: validateIntBetween(0, 23),
 									},
 									"minute": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: "Minute (0-59)",
+										Type:         schema.TypeInt,
+										Required:     true,
+										Description:  "Minute (0-59)",
+										ValidateFunc: validateIntBetween(0, 59),
 									},
 								},
 							},
 						},
 						"every_x_months": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Rotate every X months",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Rotate every X months",
+							ValidateFunc: validateIntAtLeast(1),
 						},
 					},
 				},
@@ -307,14 +350,16 @@ func resourceSchedule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"every_x_weeks": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Repeat every X weeks",
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Repeat every X weeks",
+							ValidateFunc: validateIntAtLeast(1),
 						},
 						"repeat_until_date": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Repeat until date",
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Repeat until date",
+							ValidateFunc: validateScheduleDate,
 						},
 					},
 				},
@@ -356,6 +401,36 @@ func resourceSchedule() *schema.Resource {
 				Default:     false,
 				Description: "Whether to notify on holidays",
 			},
+			"holiday_calendar_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the alertops_holiday_calendar defining which days are holidays for the schedule. Has no effect on alerts unless is_holiday_notify is true",
+			},
+			"rotation_preview_shifts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultRotationPreviewShifts,
+				Description:  "Number of shifts to list in rotation_preview; 0 turns the preview off",
+				ValidateFunc: validateIntBetween(0, 100),
+			},
+			"rotation_preview_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Date (YYYY-MM-DD) in the schedule's time zone from which rotation_preview lists shifts. Defaults to start_date rather than today, so that the preview doesn't change on every plan as time passes; past shifts are listed until this is moved forward",
+				ValidateFunc: validateScheduleDate,
+			},
+			"rotation_preview": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Upcoming shifts, computed by the provider from the schedule's settings so that plans show who will be on call",
+				Elem:        getScheduleShiftSchema(),
+			},
+			"upcoming_holidays": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Holidays of holiday_calendar_id that fall inside the shifts of rotation_preview, with the users on call each day",
+				Elem:        getHolidayShiftSchema(),
+			},
 			"debug_request_json": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -373,10 +448,11 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta in
 		ScheduleName:             d.Get("schedule_name").(string),
 		ScheduleType:             d.Get("schedule_type").(string),
 		Continuous:               d.Get("continuous").(bool),
-		TimeZone:                 d.Get("time_zone").(string),
+		TimeZone:                 alertOpsTimeZoneName(d.Get("time_zone").(string)),
 		IncludeAllUsersInGroup:   d.Get("include_all_users_in_group").(bool),
 		Enabled:                  d.Get("enabled").(bool),
 		IsHolidayNotify:          d.Get("is_holiday_notify").(bool),
+		HolidayCalendarID:        d.Get("holiday_calendar_id").(int),
 	}
 
 	if v, ok := d.GetOk("color"); ok {
@@ -441,7 +517,11 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta in
 	d.SetId(strconv.Itoa(createdSchedule.ScheduleID))
 	d.Set("schedule_id", createdSchedule.ScheduleID)
 
-	return resourceScheduleRead(ctx, d, meta)
+	diags := resourceScheduleRead(ctx, d, meta)
+	if !diags.HasError() && client.warnOnScheduleGaps {
+		diags = append(diags, checkScheduleGaps(ctx, client, schedule.Group, schedule.ScheduleName)...)
+	}
+	return append(diags, checkScheduleHolidayNotify(schedule)...)
 }
 
 func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -468,6 +548,7 @@ func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("include_all_users_in_group", schedule.IncludeAllUsersInGroup)
 	d.Set("enabled", schedule.Enabled)
 	d.Set("is_holiday_notify", schedule.IsHolidayNotify)
+	d.Set("holiday_calendar_id", schedule.HolidayCalendarID)
 
 	// Set nested objects
 	if schedule.StartDate != nil {
@@ -502,6 +583,28 @@ func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("users", flattenScheduleUsers(schedule.Users))
 	}
 
+	// Computed the same way as at plan time, from what AlertOps returned
+	from, err := expandRotationPreviewFrom(d.Get("rotation_preview_from").(string), schedule.TimeZone)
+	var shifts []ScheduleShift
+	if err == nil {
+		shifts, err = expandScheduleShifts(schedule, from, d.Get("rotation_preview_shifts").(int))
+	}
+	if err != nil {
+		log.Printf("[WARN] Can't compute rotation_preview for schedule %s: %v", d.Id(), err)
+	}
+	d.Set("rotation_preview", flattenScheduleShifts(shifts))
+
+	var holidayShifts []HolidayShift
+	if schedule.HolidayCalendarID != 0 {
+		var calendar HolidayCalendar
+		err := client.get(ctx, holidayCalendarPath(strconv.Itoa(schedule.HolidayCalendarID)), &calendar)
+		if err != nil {
+			log.Printf("[WARN] Can't compute upcoming_holidays for schedule %s: %v", d.Id(), err)
+		}
+		holidayShifts = scheduleHolidayShifts(shifts, calendar.Holidays, timeZoneLocation(schedule.TimeZone))
+	}
+	d.Set("upcoming_holidays", flattenHolidayShifts(holidayShifts))
+
 	return nil
 }
 
@@ -515,10 +618,11 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		ScheduleName:             d.Get("schedule_name").(string),
 		ScheduleType:             d.Get("schedule_type").(string),
 		Continuous:               d.Get("continuous").(bool),
-		TimeZone:                 d.Get("time_zone").(string),
+		TimeZone:                 alertOpsTimeZoneName(d.Get("time_zone").(string)),
 		IncludeAllUsersInGroup:   d.Get("include_all_users_in_group").(bool),
 		Enabled:                  d.Get("enabled").(bool),
 		IsHolidayNotify:          d.Get("is_holiday_notify").(bool),
+		HolidayCalendarID:        d.Get("holiday_calendar_id").(int),
 	}
 
 	if v, ok := d.GetOk("color"); ok {
@@ -581,7 +685,11 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(fmt.Errorf("failed to update schedule: %w", err))
 	}
 
-	return resourceScheduleRead(ctx, d, meta)
+	diags := resourceScheduleRead(ctx, d, meta)
+	if !diags.HasError() && client.warnOnScheduleGaps {
+		diags = append(diags, checkScheduleGaps(ctx, client, schedule.Group, schedule.ScheduleName)...)
+	}
+	return append(diags, checkScheduleHolidayNotify(schedule)...)
 }
 
 func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -598,6 +706,382 @@ func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
+// Number of schedules requested per page when listing a group's schedules
+const schedulesPageSize = 100
+
+// listGroupSchedules returns every schedule of a group, following pagination
+func listGroupSchedules(ctx context.Context, client *Client, groupName string) ([]Schedule, error) {
+	var schedules []Schedule
+	for offset := 0; ; offset += schedulesPageSize {
+		var listResponse ScheduleListResponse
+		err := client.get(ctx, fmt.Sprintf("/api/v2/schedules/%s?limit=%d&offset=%d", url.PathEscape(groupName), schedulesPageSize, offset), &listResponse)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list schedules of group %q: %w", groupName, err)
+		}
+
+		schedules = append(schedules, listResponse.Schedules...)
+		if len(listResponse.Schedules) < schedulesPageSize {
+			return schedules, nil
+		}
+	}
+}
+
+// loadGroupSchedules reads the enabled schedules of a group in full, ordered by
+// schedule name. Schedules that include all users in the group get its direct
+// user members, in sequence order, after their own users.
+func loadGroupSchedules(ctx context.Context, client *Client, groupName string) ([]Schedule, error) {
+	listed, err := listGroupSchedules(ctx, client, groupName)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(listed, func(i, j int) bool {
+		return strings.ToLower(listed[i].ScheduleName) < strings.ToLower(listed[j].ScheduleName)
+	})
+
+	var groupUsers []ScheduleUser
+	var schedules []Schedule
+	for _, summary := range listed {
+		// The list may leave out rotation settings; read each schedule in full
+		var schedule Schedule
+		err := client.get(ctx, fmt.Sprintf("/api/v2/schedules/%s/%d", url.PathEscape(groupName), summary.ScheduleID), &schedule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read schedule %q: %w", summary.ScheduleName, err)
+		}
+		if !schedule.Enabled {
+			continue
+		}
+
+		if schedule.IncludeAllUsersInGroup {
+			if groupUsers == nil {
+				if groupUsers, err = groupScheduleUsers(ctx, client, groupName); err != nil {
+					return nil, err
+				}
+			}
+			schedule.Users = append(append([]ScheduleUser{}, schedule.Users...), groupUsers...)
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+// groupScheduleUsers returns the direct user members of a group in sequence
+// order, with their first role, as schedule users
+func groupScheduleUsers(ctx context.Context, client *Client, groupName string) ([]ScheduleUser, error) {
+	found, err := findGroupByName(ctx, client, groupName)
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no group found with group_name %q", groupName)
+	}
+
+	var group Group
+	err = client.get(ctx, fmt.Sprintf("/api/v2/groups/%d", found.GroupID), &group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read group: %w", err)
+	}
+
+	users := []ScheduleUser{}
+	for _, member := range sortedGroupMembers(group.Members) {
+		if normalizeGroupMemberType(member.MemberType) != "User" {
+			continue
+		}
+		user := ScheduleUser{User: member.Member}
+		if len(member.Roles) > 0 {
+			user.Role = member.Roles[0]
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// resourceScheduleCustomizeDiff validates the schedule and plans rotation_preview
+// and upcoming_holidays
+func resourceScheduleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateScheduleRotation(d); err != nil {
+		return err
+	}
+	if err := planRotationPreview(d); err != nil {
+		return err
+	}
+	return planUpcomingHolidays(ctx, meta, d)
+}
+
+// checkScheduleHolidayNotify warns if a schedule has a holiday calendar but
+// doesn't notify on holidays, which leaves the calendar with no effect on alerts
+func checkScheduleHolidayNotify(schedule Schedule) diag.Diagnostics {
+	if schedule.HolidayCalendarID == 0 || schedule.IsHolidayNotify {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Schedule %q has a holiday calendar but is_holiday_notify is false", schedule.ScheduleName),
+		Detail:   fmt.Sprintf("holiday_calendar_id %d only lists the holidays in upcoming_holidays; AlertOps doesn't notify on them unless is_holiday_notify = true.", schedule.HolidayCalendarID),
+	}}
+}
+
+// checkScheduleGaps warns if the group of a schedule that was just created or
+// updated has gaps in coverage during the next scheduleGapCheckDays days. This
+// is best effort: it sees the group's schedules as AlertOps has them now, so
+// schedules applied later in the same run may still close the gaps.
+func checkScheduleGaps(ctx context.Context, client *Client, groupName, scheduleName string) diag.Diagnostics {
+	schedules, err := loadGroupSchedules(ctx, client, groupName)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Can't check group %q for schedule gaps", groupName),
+			Detail:   err.Error(),
+		}}
+	}
+
+	from := time.Now().UTC().Truncate(time.Minute)
+	to := from.AddDate(0, 0, client.scheduleGapCheckDays)
+	coverage, err := analyzeScheduleCoverage(schedules, from, to)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Can't check group %q for schedule gaps", groupName),
+			Detail:   err.Error(),
+		}}
+	}
+	if len(coverage.Gaps) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Group %q has no schedule coverage at times in the next %d days", groupName, client.scheduleGapCheckDays),
+		Detail:   fmt.Sprintf("After applying schedule %q, the group's enabled schedules leave these gaps: %s. Schedules applied later in this run may still cover them.", scheduleName, formatScheduleGaps(coverage.Gaps)),
+	}}
+}
+
+// validateScheduleRotation checks that rotation settings fit together, that
+// rotations don't rotate more users than the schedule has, and that dates are in
+// order in the schedule's time zone
+func validateScheduleRotation(d *schema.ResourceDiff) error {
+	// Exactly one rotate_* block, matching rotate_frequency
+	if d.NewValueKnown("rotate_frequency") {
+		frequency := d.Get("rotate_frequency").(string)
+		for _, f := range RotateFrequencies {
+			key := "rotate_" + f
+			if !d.NewValueKnown(key) {
+				continue
+			}
+			present := len(d.Get(key).([]interface{})) > 0
+			switch {
+			case f == frequency && !present:
+				return fmt.Errorf("rotate_frequency = %q requires a %s block", frequency, key)
+			case f != frequency && present && frequency == "":
+				return fmt.Errorf("%s requires rotate_frequency = %q", key, f)
+			case f != frequency && present:
+				return fmt.Errorf("%s can't be set with rotate_frequency = %q; use rotate_%s", key, frequency, frequency)
+			}
+		}
+		if d.NewValueKnown("schedule_type") && d.Get("schedule_type").(string) == "Rotating" && frequency == "" {
+			return fmt.Errorf("schedule_type = \"Rotating\" requires rotate_frequency")
+		}
+	}
+
+	// rotate_x_users can't exceed the users on the schedule, unless they come from the group
+	if d.NewValueKnown("users") && d.NewValueKnown("include_all_users_in_group") && !d.Get("include_all_users_in_group").(bool) {
+		users := len(d.Get("users").([]interface{}))
+		for _, f := range RotateFrequencies {
+			key := fmt.Sprintf("rotate_%s.0.rotate_x_users", f)
+			if !d.NewValueKnown(key) {
+				continue
+			}
+			if rotateXUsers, ok := d.Get(key).(int); ok && rotateXUsers > users {
+				return fmt.Errorf("%s is %d but the schedule has %d users", key, rotateXUsers, users)
+			}
+		}
+	}
+
+	// end_date and repeat_until_date must come after start_date
+	if !d.NewValueKnown("time_zone") || !d.NewValueKnown("start_date") || !d.NewValueKnown("end_date") {
+		return nil
+	}
+	location := timeZoneLocation(d.Get("time_zone").(string))
+	startDate := expandScheduleDate(d.Get("start_date").([]interface{}))
+	if startDate == nil {
+		return nil
+	}
+	start, err := scheduleDateTime(startDate, location)
+	if err != nil {
+		return fmt.Errorf("start_date: %v", err)
+	}
+
+	if endDate := expandScheduleDate(d.Get("end_date").([]interface{})); endDate != nil {
+		end, err := scheduleDateTime(endDate, location)
+		if err != nil {
+			return fmt.Errorf("end_date: %v", err)
+		}
+		if !end.After(start) {
+			return fmt.Errorf("end_date (%s) must be after start_date (%s) in %s", end.Format("2006-01-02 15:04"), start.Format("2006-01-02 15:04"), d.Get("time_zone").(string))
+		}
+	}
+
+	if d.NewValueKnown("repeat_schedule") {
+		if repeat := expandRepeatSchedule(d.Get("repeat_schedule").([]interface{})); repeat != nil && repeat.RepeatUntilDate != "" {
+			until, err := time.ParseInLocation(scheduleDateLayout, repeat.RepeatUntilDate, location)
+			if err != nil {
+				return fmt.Errorf("repeat_schedule.0.repeat_until_date: %v", err)
+			}
+			if until.Before(time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, location)) {
+				return fmt.Errorf("repeat_schedule.0.repeat_until_date (%s) must not be before start_date (%s)", repeat.RepeatUntilDate, startDate.Date)
+			}
+		}
+	}
+
+	return nil
+}
+
+// planRotationPreview computes rotation_preview from the planned schedule, or
+// leaves it unknown until the inputs are known
+func planRotationPreview(d *schema.ResourceDiff) error {
+	shifts, known, err := plannedRotationPreview(d)
+	if err != nil {
+		return err
+	}
+	if !known {
+		return d.SetNewComputed("rotation_preview")
+	}
+	return d.SetNew("rotation_preview", flattenScheduleShifts(shifts))
+}
+
+// plannedRotationPreview returns the shifts of the planned schedule's rotation
+// preview, and false if they aren't known until apply
+func plannedRotationPreview(d *schema.ResourceDiff) ([]ScheduleShift, bool, error) {
+	for _, key := range append(schedulePreviewInputs, "rotation_preview_shifts", "rotation_preview_from") {
+		if !d.NewValueKnown(key) {
+			return nil, false, nil
+		}
+	}
+
+	schedule := expandSchedulePreviewInput(d.Get)
+	from, err := expandRotationPreviewFrom(d.Get("rotation_preview_from").(string), schedule.TimeZone)
+	if err != nil {
+		return nil, false, err
+	}
+	shifts, err := expandScheduleShifts(schedule, from, d.Get("rotation_preview_shifts").(int))
+	if err != nil {
+		return nil, false, err
+	}
+	return shifts, true, nil
+}
+
+// planUpcomingHolidays computes upcoming_holidays from the planned rotation
+// preview and the holiday calendar as AlertOps has it, or leaves it unknown
+// until both are known. A calendar changed in the same run is reported as it
+// was before the change.
+func planUpcomingHolidays(ctx context.Context, meta interface{}, d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("holiday_calendar_id") {
+		return d.SetNewComputed("upcoming_holidays")
+	}
+	calendarID := d.Get("holiday_calendar_id").(int)
+	if calendarID == 0 {
+		return d.SetNew("upcoming_holidays", []interface{}{})
+	}
+
+	shifts, known, err := plannedRotationPreview(d)
+	if err != nil {
+		return err
+	}
+	client, ok := meta.(*Client)
+	if !known || !ok {
+		return d.SetNewComputed("upcoming_holidays")
+	}
+
+	var calendar HolidayCalendar
+	err = client.getCached(ctx, holidayCalendarPath(strconv.Itoa(calendarID)), &calendar)
+	if err != nil {
+		return fmt.Errorf("holiday_calendar_id: failed to read holiday calendar %d: %w", calendarID, err)
+	}
+
+	holidayShifts := scheduleHolidayShifts(shifts, calendar.Holidays, timeZoneLocation(d.Get("time_zone").(string)))
+	return d.SetNew("upcoming_holidays", flattenHolidayShifts(holidayShifts))
+}
+
+// Attributes a rotation preview is computed from
+var schedulePreviewInputs = []string{
+	"schedule_type",
+	"continuous",
+	"time_zone",
+	"start_date",
+	"end_date",
+	"start_weekday",
+	"end_weekday",
+	"schedule_weekdays",
+	"rotate_frequency",
+	"rotate_daily",
+	"rotate_weekly",
+	"rotate_monthly",
+	"repeat_schedule",
+	"users",
+}
+
+// expandSchedulePreviewInput converts Terraform data to the parts of a Schedule
+// struct a rotation preview is computed from
+func expandSchedulePreviewInput(get func(string) interface{}) Schedule {
+	return Schedule{
+		ScheduleType:     get("schedule_type").(string),
+		Continuous:       get("continuous").(bool),
+		TimeZone:         get("time_zone").(string),
+		StartDate:        expandScheduleDate(get("start_date").([]interface{})),
+		EndDate:          expandScheduleDate(get("end_date").([]interface{})),
+		StartWeekday:     get("start_weekday").(string),
+		EndWeekday:       get("end_weekday").(string),
+		ScheduleWeekdays: expandScheduleWeekdays(get("schedule_weekdays").([]interface{})),
+		RotateFrequency:  get("rotate_frequency").(string),
+		RotateDaily:      expandRotateDaily(get("rotate_daily").([]interface{})),
+		RotateWeekly:     expandRotateWeekly(get("rotate_weekly").([]interface{})),
+		RotateMonthly:    expandRotateMonthly(get("rotate_monthly").([]interface{})),
+		RepeatSchedule:   expandRepeatSchedule(get("repeat_schedule").([]interface{})),
+		Users:            expandScheduleUsers(get("users").([]interface{})),
+	}
+}
+
+// expandRotationPreviewFrom returns midnight of a YYYY-MM-DD date in a time zone,
+// or the zero time for an empty date
+func expandRotationPreviewFrom(date string, timeZone string) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, nil
+	}
+	from, err := time.ParseInLocation(scheduleDateLayout, date, timeZoneLocation(timeZone))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("date %q is not in YYYY-MM-DD format", date)
+	}
+	return from, nil
+}
+
+// Layout of schedule dates
+const scheduleDateLayout = "2006-01-02"
+
+// scheduleDateTime returns the time a ScheduleDate refers to in a location
+func scheduleDateTime(date *ScheduleDate, location *time.Location) (time.Time, error) {
+	day, err := time.ParseInLocation(scheduleDateLayout, date.Date, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("date %q is not in YYYY-MM-DD format", date.Date)
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), date.Hour, date.Minute, 0, 0, location), nil
+}
+
+// validateScheduleDate checks that a date is in YYYY-MM-DD format
+func validateScheduleDate(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if _, err := time.Parse(scheduleDateLayout, v); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a date in YYYY-MM-DD format, got: %q", key, v))
+	}
+	return
+}
+
+// validateScheduleWeekday checks that a weekday is one of Sun, Mon, ... Sat
+func validateScheduleWeekday(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if !containsString(ScheduleWeekdayNames, v) {
+		errs = append(errs, fmt.Errorf("%q must be one of %v, got: %q", key, ScheduleWeekdayNames, v))
+	}
+	return
+}
+
 // Helper functions for expanding/flattening nested structures
 
 func expandScheduleDate(dates []interface{}) *ScheduleDate {
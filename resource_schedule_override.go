@@ -0,0 +1,364 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceScheduleOverride manages a temporary change to who is on call in a
+// schedule, such as a shift swap or vacation cover. Overrides that have ended
+// stay in state, with a warning, until they're removed from the configuration.
+func resourceScheduleOverride() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScheduleOverrideCreate,
+		ReadContext:   resourceScheduleOverrideRead,
+		UpdateContext: resourceScheduleOverrideUpdate,
+		DeleteContext: resourceScheduleOverrideDelete,
+		CustomizeDiff: resourceScheduleOverrideCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"override_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The unique identifier for the override",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The group the schedule belongs to",
+			},
+			"schedule_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the schedule to override",
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Username of the user on call during the override",
+			},
+			"replaced_user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Username of the user the override replaces. If empty, user is added to whoever is on call",
+			},
+			"time_zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Time zone of start_date and end_date. Accepts the AlertOps name, a Windows time zone ID or an IANA zone such as America/New_York",
+				ValidateFunc:     validateTimeZone,
+				DiffSuppressFunc: suppressEquivalentTimeZone,
+			},
+			"start_date": getScheduleOverrideDateSchema("Start"),
+			"end_date":   getScheduleOverrideDateSchema("End"),
+		},
+	}
+}
+
+func resourceScheduleOverrideCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	override := expandScheduleOverride(d)
+	if requestJSON, jsonErr := json.Marshal(override); jsonErr == nil {
+		log.Printf("[DEBUG] Creating schedule override: %s", requestJSON)
+	}
+
+	var created ScheduleOverride
+	err := client.post(ctx, scheduleOverridesPath(override.Group, override.ScheduleID), override, &created)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create schedule override: %w", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d/%d", override.Group, override.ScheduleID, created.OverrideID))
+	return resourceScheduleOverrideRead(ctx, d, meta)
+}
+
+func resourceScheduleOverrideRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupName, scheduleID, overrideID, err := parseScheduleOverrideID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var override ScheduleOverride
+	err = client.get(ctx, fmt.Sprintf("%s/%d", scheduleOverridesPath(groupName, scheduleID), overrideID), &override)
+	if isHTTPStatus(err, http.StatusNotFound) {
+		// AlertOps may clean up ended overrides. Keeping them in state stops the
+		// next plan from trying to create them again.
+		if end, ok := scheduleOverrideEnd(d.Get("time_zone").(string), expandScheduleDate(d.Get("end_date").([]interface{}))); ok && !end.After(time.Now()) {
+			return scheduleOverrideEndedWarning(d.Id(), end)
+		}
+		log.Printf("[INFO] Schedule override %s no longer exists; removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read schedule override: %w", err))
+	}
+
+	d.Set("override_id", overrideID)
+	d.Set("group", groupName)
+	d.Set("schedule_id", scheduleID)
+	d.Set("user", override.User)
+	d.Set("replaced_user", override.ReplacedUser)
+	d.Set("time_zone", override.TimeZone)
+	d.Set("start_date", flattenScheduleDate(override.StartDate))
+	d.Set("end_date", flattenScheduleDate(override.EndDate))
+
+	// Ended overrides have no effect but stay in state, so that plans don't try
+	// to create them again
+	if end, ok := scheduleOverrideEnd(override.TimeZone, override.EndDate); ok && !end.After(time.Now()) {
+		return scheduleOverrideEndedWarning(d.Id(), end)
+	}
+
+	return nil
+}
+
+// scheduleOverrideEndedWarning tells the user that an override has ended and can
+// be removed from the configuration
+func scheduleOverrideEndedWarning(id string, end time.Time) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Schedule override %s has ended", id),
+		Detail:   fmt.Sprintf("The override ended at %s and no longer has any effect. Remove it from the configuration.", end.Format(time.RFC3339)),
+	}}
+}
+
+func resourceScheduleOverrideUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupName, scheduleID, overrideID, err := parseScheduleOverrideID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	override := expandScheduleOverride(d)
+	override.OverrideID = overrideID
+	if requestJSON, jsonErr := json.Marshal(override); jsonErr == nil {
+		log.Printf("[DEBUG] Updating schedule override %s: %s", d.Id(), requestJSON)
+	}
+
+	err = client.put(ctx, fmt.Sprintf("%s/%d", scheduleOverridesPath(groupName, scheduleID), overrideID), override, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update schedule override: %w", err))
+	}
+
+	return resourceScheduleOverrideRead(ctx, d, meta)
+}
+
+func resourceScheduleOverrideDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	groupName, scheduleID, overrideID, err := parseScheduleOverrideID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.delete(ctx, fmt.Sprintf("%s/%d", scheduleOverridesPath(groupName, scheduleID), overrideID))
+	if err != nil && !isHTTPStatus(err, http.StatusNotFound) {
+		return diag.FromErr(fmt.Errorf("failed to delete schedule override: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceScheduleOverrideCustomizeDiff checks that the override ends after it
+// starts, hasn't already ended when created, and, when created or moved, falls
+// inside the schedule's active window
+func resourceScheduleOverrideCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"time_zone", "start_date", "end_date"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	location := timeZoneLocation(d.Get("time_zone").(string))
+	startDate := expandScheduleDate(d.Get("start_date").([]interface{}))
+	endDate := expandScheduleDate(d.Get("end_date").([]interface{}))
+	if startDate == nil || endDate == nil {
+		return nil
+	}
+	start, err := scheduleDateTime(startDate, location)
+	if err != nil {
+		return fmt.Errorf("start_date: %v", err)
+	}
+	end, err := scheduleDateTime(endDate, location)
+	if err != nil {
+		return fmt.Errorf("end_date: %v", err)
+	}
+	if !end.After(start) {
+		return fmt.Errorf("end_date (%s) must be after start_date (%s)", end.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+	if d.Id() == "" && !end.After(time.Now()) {
+		return fmt.Errorf("the override ended at %s and can't be created; remove it from the configuration", end.Format(time.RFC3339))
+	}
+
+	if d.Get("user").(string) != "" && strings.EqualFold(d.Get("user").(string), d.Get("replaced_user").(string)) {
+		return fmt.Errorf("user and replaced_user must be different users")
+	}
+
+	// Existing overrides aren't checked against later changes to the schedule,
+	// which would otherwise block every plan
+	if d.Id() != "" && !d.HasChanges("time_zone", "start_date", "end_date", "replaced_user") {
+		return nil
+	}
+
+	// The schedule may be created in the same run
+	client, ok := meta.(*Client)
+	if !ok || !d.NewValueKnown("group") || !d.NewValueKnown("schedule_id") || !d.NewValueKnown("replaced_user") {
+		return nil
+	}
+	groupName := d.Get("group").(string)
+	var schedule Schedule
+	err = client.getCached(ctx, fmt.Sprintf("/api/v2/schedules/%s/%d", url.PathEscape(groupName), d.Get("schedule_id").(int)), &schedule)
+	if err != nil {
+		return fmt.Errorf("schedule_id: failed to read schedule %d of group %q: %w", d.Get("schedule_id").(int), groupName, err)
+	}
+
+	return checkScheduleOverrideWindow(schedule, d.Get("replaced_user").(string), start, end)
+}
+
+// checkScheduleOverrideWindow checks that an override from start to end is
+// within the schedule's dates, overlaps a period the schedule is active, and
+// replaces a user of the schedule
+func checkScheduleOverrideWindow(schedule Schedule, replacedUser string, start, end time.Time) error {
+	if !schedule.Enabled {
+		return fmt.Errorf("schedule %q is disabled, so the override would have no effect", schedule.ScheduleName)
+	}
+
+	location := timeZoneLocation(schedule.TimeZone)
+	if schedule.StartDate != nil {
+		scheduleStart, err := scheduleDateTime(schedule.StartDate, location)
+		if err == nil && start.Before(scheduleStart) {
+			return fmt.Errorf("the override starts at %s, before schedule %q starts at %s", start.Format(time.RFC3339), schedule.ScheduleName, scheduleStart.Format(time.RFC3339))
+		}
+	}
+	if schedule.EndDate != nil {
+		scheduleEnd, err := scheduleDateTime(schedule.EndDate, location)
+		if err == nil && end.After(scheduleEnd) {
+			return fmt.Errorf("the override ends at %s, after schedule %q ends at %s", end.Format(time.RFC3339), schedule.ScheduleName, scheduleEnd.Format(time.RFC3339))
+		}
+	}
+
+	shifts, err := expandScheduleShiftsUntil(schedule, start, end, 1)
+	if err != nil {
+		return fmt.Errorf("schedule %q: %w", schedule.ScheduleName, err)
+	}
+	if len(shifts) == 0 {
+		return fmt.Errorf("schedule %q isn't active between %s and %s, so the override would have no effect", schedule.ScheduleName, start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	if replacedUser != "" && !schedule.IncludeAllUsersInGroup && !scheduleHasUser(schedule.Users, replacedUser) {
+		return fmt.Errorf("replaced_user %q isn't a user of schedule %q", replacedUser, schedule.ScheduleName)
+	}
+	return nil
+}
+
+// scheduleOverrideEnd returns when an override ends, and false if that isn't known
+func scheduleOverrideEnd(timeZone string, endDate *ScheduleDate) (time.Time, bool) {
+	if endDate == nil {
+		return time.Time{}, false
+	}
+	end, err := scheduleDateTime(endDate, timeZoneLocation(timeZone))
+	return end, err == nil
+}
+
+// scheduleHasUser reports whether a user is one of a schedule's users
+func scheduleHasUser(users []ScheduleUser, userName string) bool {
+	for _, user := range users {
+		if strings.EqualFold(user.User, userName) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandScheduleOverride converts Terraform data to a ScheduleOverride struct
+func expandScheduleOverride(d *schema.ResourceData) ScheduleOverride {
+	return ScheduleOverride{
+		ScheduleID:   d.Get("schedule_id").(int),
+		Group:        d.Get("group").(string),
+		User:         d.Get("user").(string),
+		ReplacedUser: d.Get("replaced_user").(string),
+		TimeZone:     alertOpsTimeZoneName(d.Get("time_zone").(string)),
+		StartDate:    expandScheduleDate(d.Get("start_date").([]interface{})),
+		EndDate:      expandScheduleDate(d.Get("end_date").([]interface{})),
+	}
+}
+
+// scheduleOverridesPath returns the API path of a schedule's overrides
+func scheduleOverridesPath(groupName string, scheduleID int) string {
+	return fmt.Sprintf("/api/v2/schedules/%s/%d/overrides", url.PathEscape(groupName), scheduleID)
+}
+
+// parseScheduleOverrideID splits a group/schedule_id/override_id ID. The group
+// name may itself contain slashes.
+func parseScheduleOverrideID(id string) (string, int, int, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 3 {
+		return "", 0, 0, fmt.Errorf("unexpected ID %q, expected group/schedule_id/override_id", id)
+	}
+	n := len(parts)
+	scheduleID, err := strconv.Atoi(parts[n-2])
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("unexpected ID %q, schedule_id must be numeric", id)
+	}
+	overrideID, err := strconv.Atoi(parts[n-1])
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("unexpected ID %q, override_id must be numeric", id)
+	}
+	groupName := strings.Join(parts[:n-2], "/")
+	if groupName == "" {
+		return "", 0, 0, fmt.Errorf("unexpected ID %q, group is empty", id)
+	}
+	return groupName, scheduleID, overrideID, nil
+}
+
+// Helper function to get schedule override date schema
+func getScheduleOverrideDateSchema(which string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Required:    true,
+		Description: which + " of the override, in time_zone",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"date": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  which + " date (YYYY-MM-DD format)",
+					ValidateFunc: validateScheduleDate,
+				},
+				"hour": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  which + " hour (0-23)",
+					ValidateFunc: validateIntBetween(0, 23),
+				},
+				"minute": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  which + " minute (0-59)",
+					ValidateFunc: validateIntBetween(0, 59),
+				},
+			},
+		},
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -20,200 +23,316 @@ func resourceUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
-			"user_id": {
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Description: "User ID",
-			},
-			"debug_request_json": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "DEBUG: The exact JSON that will be sent to AlertOps API",
-			},
-			"user_name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Username for the user",
-			},
-			"first_name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "First name of the user",
-			},
-			"last_name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Last name of the user",
-			},
-			"locale": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "en-US",
-				Description: "Locale of the user",
-			},
-			"time_zone": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Time zone of the user",
-			},
-			"type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "Standard",
-				Description: "Type of the user (Standard, etc.)",
-			},
-			"external_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "External ID for the user",
+		CustomizeDiff: resourceUserCustomizeDiff,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceUserV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceUserStateUpgradeV0,
+				Version: 0,
 			},
-			"last_login_date": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Last login date",
-			},
-			"contact_methods": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Contact methods for the user",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"contact_method_name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Name of the contact method (Email-Official, Phone-Official, SMS-Official, Email-Official-SMS Gateway, Email-Personal, Email-Personal-SMS Gateway, Phone-Official-Mobile, Phone-Personal, Phone-Personal-Mobile, SMS-Personal)",
-							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-								v := val.(string)
-								for _, valid := range ValidContactMethodTypes {
-									if v == valid {
-										return
-									}
+		},
+		Schema: resourceUserSchema(),
+	}
+}
+
+// resourceUserSchema returns the alertops_user schema
+func resourceUserSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"user_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "User ID",
+		},
+		"debug_request_json": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "DEBUG: The exact JSON that will be sent to AlertOps API",
+		},
+		"user_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Username for the user",
+		},
+		"first_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "First name of the user",
+		},
+		"last_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Last name of the user",
+		},
+		"locale": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "en-US",
+			Description:      "Locale of the user, e.g. en-US",
+			ValidateFunc:     validateLocale,
+			DiffSuppressFunc: suppressEquivalentLocale,
+		},
+		"time_zone": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Time zone of the user. Accepts the AlertOps name, e.g. \"(UTC-05:00) Eastern Time (US & Canada)\", a Windows time zone ID or an IANA zone such as America/New_York",
+			ValidateFunc:     validateTimeZone,
+			DiffSuppressFunc: suppressEquivalentTimeZone,
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "Standard",
+			Description: "Type of the user (Standard, etc.)",
+		},
+		"external_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "External ID for the user",
+		},
+		"last_login_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Last login date",
+		},
+		"contact_methods": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Contact methods for the user, keyed by contact_method_name",
+			Set:         hashContactMethod,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"contact_method_name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the contact method (Email-Official, Phone-Official, SMS-Official, Email-Official-SMS Gateway, Email-Personal, Email-Personal-SMS Gateway, Phone-Official-Mobile, Phone-Personal, Phone-Personal-Mobile, SMS-Personal)",
+						ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+							v := val.(string)
+							for _, valid := range ValidContactMethodTypes {
+								if v == valid {
+									return
 								}
-								errs = append(errs, fmt.Errorf("contact_method_name must be one of: %v", ValidContactMethodTypes))
-								return
-							},
+							}
+							errs = append(errs, fmt.Errorf("contact_method_name must be one of: %v", ValidContactMethodTypes))
+							return
 						},
-						"email": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Email contact",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"email_address": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Email address",
-									},
+					},
+					"email": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "Email contact",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"email_address": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "Email address",
 								},
 							},
 						},
-						"phone": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Phone contact",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"country_code": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Country code",
-									},
-									"phone_number": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Phone number",
-									},
-									"extension": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Extension",
-									},
+					},
+					"phone": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "Phone contact",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"country_code": {
+									Type:             schema.TypeString,
+									Required:         true,
+									Description:      "Country calling code, e.g. 1 or 44",
+									DiffSuppressFunc: suppressEquivalentCountryCode,
+								},
+								"phone_number": {
+									Type:             schema.TypeString,
+									Required:         true,
+									Description:      "Phone number. Validated against country_code; formatting differences are ignored",
+									DiffSuppressFunc: suppressEquivalentPhoneNumber,
+								},
+								"e164": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Phone number in E.164 format",
+								},
+								"extension": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "Extension",
 								},
 							},
 						},
-						"sms": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "SMS contact",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"country_code": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Country code",
-									},
-									"phone_number": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Phone number",
-									},
+					},
+					"sms": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "SMS contact",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"country_code": {
+									Type:             schema.TypeString,
+									Required:         true,
+									Description:      "Country calling code, e.g. 1 or 44",
+									DiffSuppressFunc: suppressEquivalentCountryCode,
+								},
+								"phone_number": {
+									Type:             schema.TypeString,
+									Required:         true,
+									Description:      "Phone number. Validated against country_code; formatting differences are ignored",
+									DiffSuppressFunc: suppressEquivalentPhoneNumber,
+								},
+								"e164": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Phone number in E.164 format",
 								},
 							},
 						},
-						"wait_time_in_mins": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Wait time in minutes",
-						},
-						"repeat": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Whether to repeat notifications",
-						},
-						"repeat_times": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Number of times to repeat",
-						},
-						"repeat_minutes": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Minutes between repeats",
-						},
-						"notification_time24x7": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "24x7 notification",
-						},
-						"enabled": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     true,
-							Description: "Whether contact method is enabled",
+					},
+					"gateway": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "Email to SMS gateway contact",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"provider": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "Gateway provider",
+								},
+								"address": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "Gateway address",
+								},
+							},
 						},
-						"sequence": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Sequence order",
+					},
+					"slack_dm": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "Slack direct message contact",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"member_id": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "Slack member ID",
+								},
+							},
 						},
 					},
+					"wait_time_in_mins": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Wait time in minutes",
+					},
+					"repeat": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Whether to repeat notifications",
+					},
+					"repeat_times": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Number of times to repeat",
+					},
+					"repeat_minutes": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Minutes between repeats",
+					},
+					"notification_time24x7": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "24x7 notification. Cannot be combined with notification_times",
+					},
+					"notification_times": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Windows during which the contact method is notified",
+						Elem:        getNotificationTimeSchema(),
+					},
+					"enabled": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+						Description: "Whether contact method is enabled",
+					},
+					"sequence": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "Sequence order. Must be unique across contact methods; assigned by AlertOps when not set",
+					},
 				},
 			},
-			"roles": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "User roles",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
+		},
+		"roles": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "User roles",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
 		},
+		"adopt_existing": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "On create, take over an existing user with the same user_name instead of failing",
+		},
+		"adopt_if_external_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only adopt an existing user whose external_id matches this value",
+		},
+		"adopted": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the user already existed and was adopted rather than created by Terraform",
+		},
+		"deletion_policy": getDeletionPolicySchema(DeletionPolicies, "deactivate disables all of the user's contact methods but keeps the account and its alert history. The AlertOps API can't disable a user's login, so deactivated users can still sign in"),
 	}
 }
 
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client)
 
+	if d.Get("adopt_existing").(bool) {
+		existing, err := findUserByName(ctx, client, d.Get("user_name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if existing != nil {
+			if externalID, ok := d.GetOk("adopt_if_external_id"); ok && existing.ExternalID != externalID.(string) {
+				return diag.Errorf("user %q already exists with external_id %q, not %q; refusing to adopt it", existing.UserName, existing.ExternalID, externalID.(string))
+			}
+
+			log.Printf("[INFO] Adopting existing user %s (%d)", existing.UserName, existing.UserID)
+			d.SetId(strconv.Itoa(existing.UserID))
+			d.Set("adopted", true)
+
+			// Don't keep a failed adoption in state, where it would be tainted and the
+			// next apply would destroy a user Terraform did not create
+			diags := resourceUserUpdate(ctx, d, meta)
+			if diags.HasError() {
+				d.SetId("")
+			}
+			return diags
+		}
+	}
+
 	user := UserCreateRequest{
 		UserName:  d.Get("user_name").(string),
 		FirstName: d.Get("first_name").(string),
 		LastName:  d.Get("last_name").(string),
-		Locale:    d.Get("locale").(string),
-		TimeZone:  d.Get("time_zone").(string),
+		Locale:    alertOpsLocale(d.Get("locale").(string)),
+		TimeZone:  alertOpsTimeZoneName(d.Get("time_zone").(string)),
 		Type:      d.Get("type").(string),
 	}
 
@@ -221,7 +340,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		user.ExternalID = externalID.(string)
 	}
 
-	if contactMethods := d.Get("contact_methods").([]interface{}); len(contactMethods) > 0 {
+	if contactMethods := d.Get("contact_methods").(*schema.Set).List(); len(contactMethods) > 0 {
 		user.ContactMethods = expandContactMethods(contactMethods)
 	}
 
@@ -253,6 +372,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	d.SetId(strconv.Itoa(result.UserID))
+	d.Set("adopted", false)
 	return resourceUserRead(ctx, d, meta)
 }
 
@@ -275,12 +395,13 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("external_id", user.ExternalID)
 	d.Set("last_login_date", user.LastLoginDate)
 
-	if len(user.ContactMethods) > 0 {
-		d.Set("contact_methods", flattenContactMethods(user.ContactMethods))
-	}
+	// Always set lists so that entries removed outside Terraform show as drift
+	d.Set("contact_methods", flattenContactMethods(user.ContactMethods))
+	d.Set("roles", user.Roles)
 
-	if len(user.Roles) > 0 {
-		d.Set("roles", user.Roles)
+	// deletion_policy is not stored in AlertOps; default it for imported users
+	if _, ok := d.GetOk("deletion_policy"); !ok {
+		d.Set("deletion_policy", "delete")
 	}
 
 	return nil
@@ -293,16 +414,20 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		UserName:  d.Get("user_name").(string),
 		FirstName: d.Get("first_name").(string),
 		LastName:  d.Get("last_name").(string),
-		Locale:    d.Get("locale").(string),
-		TimeZone:  d.Get("time_zone").(string),
+		Locale:    alertOpsLocale(d.Get("locale").(string)),
+		TimeZone:  alertOpsTimeZoneName(d.Get("time_zone").(string)),
 		Type:      d.Get("type").(string),
+
+		// Sent even when empty so that removing them in config clears them in AlertOps
+		ContactMethods: []ContactMethod{},
+		Roles:          []string{},
 	}
 
 	if externalID, ok := d.GetOk("external_id"); ok {
 		user.ExternalID = externalID.(string)
 	}
 
-	if contactMethods := d.Get("contact_methods").([]interface{}); len(contactMethods) > 0 {
+	if contactMethods := d.Get("contact_methods").(*schema.Set).List(); len(contactMethods) > 0 {
 		user.ContactMethods = expandContactMethods(contactMethods)
 	}
 
@@ -321,9 +446,47 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client)
 
-	err := client.delete(ctx, fmt.Sprintf("/api/v2/users/%s", d.Id()))
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete user: %w", err))
+	switch d.Get("deletion_policy").(string) {
+	case "retain":
+		log.Printf("[INFO] Retaining user %s in AlertOps, removing it from state only", d.Id())
+	case "deactivate":
+		var user User
+		err := client.get(ctx, fmt.Sprintf("/api/v2/users/%s", d.Id()), &user)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to read user: %w", err))
+		}
+
+		request := UserUpdateRequest{
+			UserName:       user.UserName,
+			FirstName:      user.FirstName,
+			LastName:       user.LastName,
+			Locale:         user.Locale,
+			TimeZone:       user.TimeZone,
+			Type:           user.Type,
+			ExternalID:     user.ExternalID,
+			ContactMethods: make([]ContactMethod, len(user.ContactMethods)),
+			Roles:          user.Roles,
+		}
+		for i, cm := range user.ContactMethods {
+			cm.Enabled = false
+			request.ContactMethods[i] = cm
+		}
+		if request.Roles == nil {
+			request.Roles = []string{}
+		}
+
+		err = client.put(ctx, fmt.Sprintf("/api/v2/users/%s", d.Id()), request, nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to deactivate user: %w", err))
+		}
+	default:
+		if d.Get("adopted").(bool) {
+			log.Printf("[WARN] Deleting user %s, which existed before Terraform adopted it", d.Id())
+		}
+		err := client.delete(ctx, fmt.Sprintf("/api/v2/users/%s", d.Id()))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to delete user: %w", err))
+		}
 	}
 
 	d.SetId("")
@@ -422,6 +585,11 @@ func dataSourceUser() *schema.Resource {
 										Computed:    true,
 										Description: "Phone number",
 									},
+									"e164": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Phone number in E.164 format",
+									},
 									"extension": {
 										Type:        schema.TypeString,
 										Computed:    true,
@@ -446,6 +614,44 @@ func dataSourceUser() *schema.Resource {
 										Computed:    true,
 										Description: "Phone number",
 									},
+									"e164": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Phone number in E.164 format",
+									},
+								},
+							},
+						},
+						"gateway": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Email to SMS gateway contact",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"provider": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Gateway provider",
+									},
+									"address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Gateway address",
+									},
+								},
+							},
+						},
+						"slack_dm": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Slack direct message contact",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"member_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Slack member ID",
+									},
 								},
 							},
 						},
@@ -474,6 +680,12 @@ func dataSourceUser() *schema.Resource {
 							Computed:    true,
 							Description: "24x7 notification",
 						},
+						"notification_times": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Windows during which the contact method is notified",
+							Elem:        getNotificationTimeDataSourceSchema(),
+						},
 						"enabled": {
 							Type:        schema.TypeBool,
 							Computed:    true,
@@ -532,15 +744,14 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	// Otherwise, search by user_name
-	var listResponse UserListResponse
-	err := client.get(ctx, "/api/v2/users", &listResponse)
+	users, err := listUsers(ctx, client, false)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to list users: %w", err))
+		return diag.FromErr(err)
 	}
 
 	userName := d.Get("user_name").(string)
 
-	for _, user := range listResponse.Users {
+	for _, user := range users {
 		if userName != "" && user.UserName == userName {
 			d.SetId(strconv.Itoa(user.UserID))
 			d.Set("user_id", user.UserID)
@@ -604,6 +815,21 @@ func expandContactMethods(methods []interface{}) []ContactMethod {
 			}
 		}
 
+		if gatewayList, ok := methodMap["gateway"].([]interface{}); ok && len(gatewayList) > 0 && gatewayList[0] != nil {
+			gatewayMap := gatewayList[0].(map[string]interface{})
+			cm.Gateway = &GatewayContact{
+				Provider: gatewayMap["provider"].(string),
+				Address:  gatewayMap["address"].(string),
+			}
+		}
+
+		if slackList, ok := methodMap["slack_dm"].([]interface{}); ok && len(slackList) > 0 && slackList[0] != nil {
+			slackMap := slackList[0].(map[string]interface{})
+			cm.SlackDM = &SlackDMContact{
+				MemberID: slackMap["member_id"].(string),
+			}
+		}
+
 		if wait, ok := methodMap["wait_time_in_mins"].(int); ok {
 			cm.WaitTimeInMins = wait
 		}
@@ -619,6 +845,9 @@ func expandContactMethods(methods []interface{}) []ContactMethod {
 		if notif24x7, ok := methodMap["notification_time24x7"].(bool); ok {
 			cm.NotificationTime24x7 = notif24x7
 		}
+		if times, ok := methodMap["notification_times"].([]interface{}); ok && len(times) > 0 {
+			cm.NotificationTimes = expandNotificationTimes(times)
+		}
 		if enabled, ok := methodMap["enabled"].(bool); ok {
 			cm.Enabled = enabled
 		}
@@ -629,6 +858,18 @@ func expandContactMethods(methods []interface{}) []ContactMethod {
 		result[i] = cm
 	}
 
+	// Contact methods come from a set, so send them in a stable order: by
+	// sequence, with unsequenced methods last, then by name
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Sequence != result[j].Sequence {
+			if result[i].Sequence == 0 || result[j].Sequence == 0 {
+				return result[j].Sequence == 0
+			}
+			return result[i].Sequence < result[j].Sequence
+		}
+		return result[i].ContactMethodName < result[j].ContactMethodName
+	})
+
 	return result
 }
 
@@ -659,6 +900,7 @@ func flattenContactMethods(methods []ContactMethod) []interface{} {
 			phone := map[string]interface{}{
 				"country_code": method.Phone.CountryCode,
 				"phone_number": method.Phone.PhoneNumber,
+				"e164":         phoneNumberE164(method.Phone.CountryCode, method.Phone.PhoneNumber),
 			}
 			if method.Phone.Extension != "" {
 				phone["extension"] = method.Phone.Extension
@@ -671,10 +913,32 @@ func flattenContactMethods(methods []ContactMethod) []interface{} {
 				map[string]interface{}{
 					"country_code": method.SMS.CountryCode,
 					"phone_number": method.SMS.PhoneNumber,
+					"e164":         phoneNumberE164(method.SMS.CountryCode, method.SMS.PhoneNumber),
+				},
+			}
+		}
+
+		if method.Gateway != nil {
+			m["gateway"] = []interface{}{
+				map[string]interface{}{
+					"provider": method.Gateway.Provider,
+					"address":  method.Gateway.Address,
 				},
 			}
 		}
 
+		if method.SlackDM != nil {
+			m["slack_dm"] = []interface{}{
+				map[string]interface{}{
+					"member_id": method.SlackDM.MemberID,
+				},
+			}
+		}
+
+		if len(method.NotificationTimes) > 0 {
+			m["notification_times"] = flattenNotificationTimes(method.NotificationTimes)
+		}
+
 		result[i] = m
 	}
 
@@ -687,4 +951,688 @@ func expandStringSlice(slice []interface{}) []string {
 		result[i] = v.(string)
 	}
 	return result
-} 
\ No newline at end of file
+} 
+
+// Helper function to get notification time schema
+func getNotificationTimeSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"notification_time_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Notification time ID",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the notification window",
+			},
+			"sunday": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Notify on Sunday",
+			},
+			"monday": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Notify on Monday",
+			},
+			"tuesday": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Notify on Tuesday",
+			},
+			"wednesday": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Notify on Wednesday",
+			},
+			"thursday": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Notify on Thursday",
+			},
+			"friday": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Notify on Friday",
+			},
+			"saturday": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Notify on Saturday",
+			},
+			"start_hour": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "Start hour (0-23)",
+				ValidateFunc: validateIntBetween(0, 23),
+			},
+			"start_minute": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Start minute (0-59)",
+				ValidateFunc: validateIntBetween(0, 59),
+			},
+			"end_hour": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "End hour (0-24, where 24 with end_minute 0 is the end of the day). An end before the start time ends the window on the next day",
+				ValidateFunc: validateIntBetween(0, 24),
+			},
+			"end_minute": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "End minute (0-59)",
+				ValidateFunc: validateIntBetween(0, 59),
+			},
+		},
+	}
+}
+
+// Helper function to get notification time schema for data sources
+func getNotificationTimeDataSourceSchema() *schema.Resource {
+	result := getNotificationTimeSchema()
+	for _, s := range result.Schema {
+		s.Required = false
+		s.Optional = false
+		s.Computed = true
+		s.ValidateFunc = nil
+	}
+	return result
+}
+
+// expandNotificationTimes converts Terraform data to NotificationTime structs
+func expandNotificationTimes(times []interface{}) []NotificationTime {
+	result := make([]NotificationTime, 0, len(times))
+
+	for _, t := range times {
+		if t == nil {
+			continue
+		}
+		m := t.(map[string]interface{})
+
+		result = append(result, NotificationTime{
+			NotificationTimeID: m["notification_time_id"].(int),
+			Name:               m["name"].(string),
+			Sunday:             m["sunday"].(bool),
+			Monday:             m["monday"].(bool),
+			Tuesday:            m["tuesday"].(bool),
+			Wednesday:          m["wednesday"].(bool),
+			Thursday:           m["thursday"].(bool),
+			Friday:             m["friday"].(bool),
+			Saturday:           m["saturday"].(bool),
+			StartHour:          m["start_hour"].(int),
+			StartMinute:        m["start_minute"].(int),
+			EndHour:            m["end_hour"].(int),
+			EndMinute:          m["end_minute"].(int),
+		})
+	}
+
+	return result
+}
+
+// flattenNotificationTimes converts NotificationTime structs to Terraform data
+func flattenNotificationTimes(times []NotificationTime) []interface{} {
+	result := make([]interface{}, len(times))
+
+	for i, t := range times {
+		result[i] = map[string]interface{}{
+			"notification_time_id": t.NotificationTimeID,
+			"name":                 t.Name,
+			"sunday":               t.Sunday,
+			"monday":               t.Monday,
+			"tuesday":              t.Tuesday,
+			"wednesday":            t.Wednesday,
+			"thursday":             t.Thursday,
+			"friday":               t.Friday,
+			"saturday":             t.Saturday,
+			"start_hour":           t.StartHour,
+			"start_minute":         t.StartMinute,
+			"end_hour":             t.EndHour,
+			"end_minute":           t.EndMinute,
+		}
+	}
+
+	return result
+}
+
+// resourceUserCustomizeDiff validates contact method names, sequences, phone numbers and
+// notification windows, and records the user name for group member checks
+func resourceUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Groups planned after this user may reference it by name before it exists
+	if client, ok := meta.(*Client); ok && d.NewValueKnown("user_name") {
+		client.addPlannedName("User", d.Get("user_name").(string))
+	}
+
+	// Contact methods are keyed by name, so duplicates would silently collapse into
+	// a single set element; detect them in the raw configuration instead
+	if err := validateUniqueContactMethodNames(d.GetRawConfig()); err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("contact_methods") {
+		return nil
+	}
+
+	sequences := make(map[int]string)
+	for _, method := range d.Get("contact_methods").(*schema.Set).List() {
+		if method == nil {
+			continue
+		}
+		methodMap := method.(map[string]interface{})
+		name := methodMap["contact_method_name"].(string)
+
+		if seq, _ := methodMap["sequence"].(int); seq > 0 {
+			if other, ok := sequences[seq]; ok {
+				return fmt.Errorf("contact_methods %q and %q both use sequence %d", other, name, seq)
+			}
+			sequences[seq] = name
+		}
+
+		// Nested blocks of set elements are not populated when reading the whole
+		// set from a ResourceDiff, so read them by their set address
+		prefix := fmt.Sprintf("contact_methods.%d", hashContactMethod(methodMap))
+
+		for _, block := range []string{"phone", "sms"} {
+			numberKey := prefix + "." + block + ".0.phone_number"
+			countryKey := prefix + "." + block + ".0.country_code"
+			if !d.NewValueKnown(numberKey) || !d.NewValueKnown(countryKey) {
+				continue
+			}
+			number := d.Get(numberKey).(string)
+			if number == "" {
+				continue
+			}
+			mobileOnly := block == "sms" || isMobileContactMethod(name)
+			if err := validatePhoneNumber(d.Get(countryKey).(string), number, mobileOnly); err != nil {
+				return fmt.Errorf("contact_methods %q: %s: %v", name, block, err)
+			}
+		}
+
+		times, _ := d.Get(prefix + ".notification_times").([]interface{})
+		if len(times) == 0 {
+			continue
+		}
+
+		if notif24x7, _ := methodMap["notification_time24x7"].(bool); notif24x7 {
+			return fmt.Errorf("contact_methods %q: notification_times cannot be set when notification_time24x7 is true", name)
+		}
+
+		if err := validateNotificationTimes(expandNotificationTimes(times)); err != nil {
+			return fmt.Errorf("contact_methods %q: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// validateUniqueContactMethodNames rejects configurations that declare the same
+// contact_method_name more than once
+func validateUniqueContactMethodNames(config cty.Value) error {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute("contact_methods") {
+		return nil
+	}
+
+	methods := config.GetAttr("contact_methods")
+	if methods.IsNull() || !methods.IsKnown() {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for it := methods.ElementIterator(); it.Next(); {
+		_, method := it.Element()
+		if method.IsNull() || !method.IsKnown() {
+			continue
+		}
+		name := method.GetAttr("contact_method_name")
+		if name.IsNull() || !name.IsKnown() {
+			continue
+		}
+		if seen[name.AsString()] {
+			return fmt.Errorf("contact_methods: %q is declared more than once; each contact_method_name may only be used once per user", name.AsString())
+		}
+		seen[name.AsString()] = true
+	}
+
+	return nil
+}
+
+// hashContactMethod keys contact methods by contact_method_name
+func hashContactMethod(v interface{}) int {
+	m := v.(map[string]interface{})
+	return schema.HashString(m["contact_method_name"])
+}
+
+// resourceUserV0 is the user schema before contact_methods became a set. It is a
+// frozen copy, so later changes to the live schema don't change how old state is
+// decoded; only types and nesting matter to the upgrade.
+func resourceUserV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"debug_request_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"time_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"external_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_login_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_methods": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_method_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"email": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"email_address": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"phone": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"country_code": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"phone_number": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"extension": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"sms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"country_code": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"phone_number": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"gateway": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"provider": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"address": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"slack_dm": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"member_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"wait_time_in_mins": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"repeat": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"repeat_times": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"repeat_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"notification_time24x7": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"notification_times": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"notification_time_id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sunday": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"monday": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"tuesday": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"wednesday": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"thursday": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"friday": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"saturday": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"start_hour": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"start_minute": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"end_hour": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"end_minute": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"sequence": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// resourceUserStateUpgradeV0 migrates contact_methods from a list to a set keyed by
+// contact_method_name. Duplicate names keep their first entry.
+func resourceUserStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	methods, ok := rawState["contact_methods"].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+
+	seen := make(map[string]bool)
+	upgraded := make([]interface{}, 0, len(methods))
+	for _, method := range methods {
+		methodMap, ok := method.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := methodMap["contact_method_name"].(string)
+		if seen[name] {
+			log.Printf("[WARN] Dropping duplicate contact method %q from user state during upgrade", name)
+			continue
+		}
+		seen[name] = true
+		upgraded = append(upgraded, methodMap)
+	}
+
+	rawState["contact_methods"] = upgraded
+	return rawState, nil
+}
+
+// Minutes in a day and in a week
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+)
+
+// validateNotificationTimes checks that each window selects at least one day, has
+// different start and end times and does not overlap another window. A window
+// that ends before it starts runs overnight into the next day.
+func validateNotificationTimes(times []NotificationTime) error {
+	days := []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+	for i, t := range times {
+		if !t.Sunday && !t.Monday && !t.Tuesday && !t.Wednesday && !t.Thursday && !t.Friday && !t.Saturday {
+			return fmt.Errorf("notification_times.%d must select at least one day", i)
+		}
+		if t.EndHour == 24 && t.EndMinute != 0 {
+			return fmt.Errorf("notification_times.%d: end_minute must be 0 when end_hour is 24", i)
+		}
+		if notificationTimeMinutes(t.EndHour, t.EndMinute) == notificationTimeMinutes(t.StartHour, t.StartMinute) {
+			return fmt.Errorf("notification_times.%d: end time %02d:%02d must differ from start time %02d:%02d", i, t.EndHour, t.EndMinute, t.StartHour, t.StartMinute)
+		}
+	}
+
+	for i := 0; i < len(times); i++ {
+		for j := i + 1; j < len(times); j++ {
+			for _, a := range notificationTimeIntervals(times[i]) {
+				for _, b := range notificationTimeIntervals(times[j]) {
+					if a[0] < b[1] && b[0] < a[1] {
+						day := (a[0] / minutesPerDay) % 7
+						if b[0] > a[0] {
+							day = (b[0] / minutesPerDay) % 7
+						}
+						return fmt.Errorf("notification_times.%d and notification_times.%d overlap on %s", i, j, days[day])
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// notificationTimeDays returns the selected days of a window indexed from Sunday
+func notificationTimeDays(t NotificationTime) [7]bool {
+	return [7]bool{t.Sunday, t.Monday, t.Tuesday, t.Wednesday, t.Thursday, t.Friday, t.Saturday}
+}
+
+// notificationTimeIntervals returns the [start, end) minutes since the start of
+// the week covered by a window. Overnight windows end on the next day, and
+// Saturday's wrap around to Sunday.
+func notificationTimeIntervals(t NotificationTime) [][2]int {
+	start := notificationTimeMinutes(t.StartHour, t.StartMinute)
+	end := notificationTimeMinutes(t.EndHour, t.EndMinute)
+	if end <= start {
+		end += minutesPerDay
+	}
+
+	var intervals [][2]int
+	for day, selected := range notificationTimeDays(t) {
+		if !selected {
+			continue
+		}
+		s, e := day*minutesPerDay+start, day*minutesPerDay+end
+		if e > minutesPerWeek {
+			intervals = append(intervals, [2]int{s, minutesPerWeek}, [2]int{0, e - minutesPerWeek})
+		} else {
+			intervals = append(intervals, [2]int{s, e})
+		}
+	}
+	return intervals
+}
+
+// notificationTimeMinutes converts an hour and minute to minutes since midnight
+func notificationTimeMinutes(hour, minute int) int {
+	return hour*60 + minute
+}
+
+// validateIntBetween returns a ValidateFunc that checks an int is within [min, max]
+func validateIntBetween(min, max int) schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(int)
+		if v < min || v > max {
+			errs = append(errs, fmt.Errorf("%q must be between %d and %d, got: %d", key, min, max, v))
+		}
+		return
+	}
+}
+
+// validateIntAtLeast returns a ValidateFunc that checks an int is at least min
+func validateIntAtLeast(min int) schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(int)
+		if v < min {
+			errs = append(errs, fmt.Errorf("%q must be at least %d, got: %d", key, min, v))
+		}
+		return
+	}
+}
+
+// Number of users requested per page when listing users
+const usersPageSize = 100
+
+// listUsers returns every user, following pagination. Cached listings use the
+// provider-wide plan cache.
+func listUsers(ctx context.Context, client *Client, cached bool) ([]User, error) {
+	get := client.get
+	if cached {
+		get = client.getCached
+	}
+
+	var users []User
+	for offset := 0; ; offset += usersPageSize {
+		var listResponse UserListResponse
+		err := get(ctx, fmt.Sprintf("/api/v2/users?limit=%d&offset=%d", usersPageSize, offset), &listResponse)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		users = append(users, listResponse.Users...)
+		if len(listResponse.Users) < usersPageSize {
+			return users, nil
+		}
+	}
+}
+
+// findUserByName returns the user with the given user_name, compared case-insensitively,
+// or nil if there is none
+func findUserByName(ctx context.Context, client *Client, userName string) (*User, error) {
+	users, err := listUsers(ctx, client, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range users {
+		if strings.EqualFold(users[i].UserName, userName) {
+			return &users[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Helper function to get deletion_policy schema
+func getDeletionPolicySchema(policies []string, deactivate string) *schema.Schema {
+	description := fmt.Sprintf("What happens when the resource is destroyed (%s). retain only removes it from state", strings.Join(policies, ", "))
+	if deactivate != "" {
+		description += "; " + deactivate
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "delete",
+		Description: description,
+		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+			v := val.(string)
+			for _, policy := range policies {
+				if v == policy {
+					return
+				}
+			}
+			errs = append(errs, fmt.Errorf("%q must be one of %v, got: %q", key, policies, v))
+			return
+		},
+	}
+}
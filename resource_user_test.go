@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testAPIServer is a stub AlertOps API that serves object for every GET and
+// records the body of the last PUT
+type testAPIServer struct {
+	*httptest.Server
+	object  interface{}
+	lastPut map[string]interface{}
+}
+
+func newTestAPIServer(t *testing.T, object interface{}) *testAPIServer {
+	t.Helper()
+
+	s := &testAPIServer{object: object}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(s.object)
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			s.lastPut = nil
+			if err := json.Unmarshal(body, &s.lastPut); err != nil {
+				t.Errorf("PUT %s: invalid JSON body: %v", r.URL.Path, err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func testUserConfig(contactMethods ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"user_name":       "jdoe",
+		"first_name":      "Jane",
+		"last_name":       "Doe",
+		"locale":          "en-US",
+		"time_zone":       "(UTC-05:00) Eastern Time (US & Canada)",
+		"type":            "Standard",
+		"contact_methods": contactMethods,
+	}
+}
+
+func testEmailContactMethod(name, address string) map[string]interface{} {
+	return map[string]interface{}{
+		"contact_method_name": name,
+		"email":               []interface{}{map[string]interface{}{"email_address": address}},
+	}
+}
+
+func testUser(contactMethods ...ContactMethod) User {
+	return User{
+		UserID:         42,
+		UserName:       "jdoe",
+		FirstName:      "Jane",
+		LastName:       "Doe",
+		Locale:         "en-US",
+		TimeZone:       "(UTC-05:00) Eastern Time (US & Canada)",
+		Type:           "Standard",
+		ContactMethods: contactMethods,
+	}
+}
+
+func TestResourceUserReadContactMethodsRemovedOutsideTerraform(t *testing.T) {
+	server := newTestAPIServer(t, testUser())
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, testUserConfig(testEmailContactMethod("Email-Official", "jdoe@example.com")))
+	d.SetId("42")
+
+	if diags := resourceUserRead(context.Background(), d, NewClient("key", server.URL)); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	if n := d.Get("contact_methods").(*schema.Set).Len(); n != 0 {
+		t.Errorf("contact_methods has %d entries, want 0 after they were removed in AlertOps", n)
+	}
+}
+
+func TestResourceUserReadContactMethodsAddedOutsideTerraform(t *testing.T) {
+	server := newTestAPIServer(t, testUser(ContactMethod{
+		ContactMethodName: "Email-Personal",
+		Email:             &EmailContact{EmailAddress: "jane@example.net"},
+		Enabled:           true,
+	}))
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, testUserConfig())
+	d.SetId("42")
+
+	if diags := resourceUserRead(context.Background(), d, NewClient("key", server.URL)); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	methods := d.Get("contact_methods").(*schema.Set).List()
+	if len(methods) != 1 {
+		t.Fatalf("contact_methods has %d entries, want the 1 added in AlertOps", len(methods))
+	}
+	if name := methods[0].(map[string]interface{})["contact_method_name"]; name != "Email-Personal" {
+		t.Errorf("contact_method_name = %v, want Email-Personal", name)
+	}
+}
+
+// testUserData returns ResourceData for an update from prior state to config,
+// the way Terraform builds it during apply
+func testUserData(t *testing.T, prior, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	r := resourceUser()
+	priorData := schema.TestResourceDataRaw(t, r.Schema, prior)
+	priorData.SetId("42")
+	state := priorData.State()
+
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil, nil, false)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("data failed: %v", err)
+	}
+	return d
+}
+
+func TestResourceUserUpdateClearsContactMethods(t *testing.T) {
+	server := newTestAPIServer(t, testUser())
+
+	// Prior state has a contact method and a role that the configuration no
+	// longer lists
+	prior := testUserConfig(testEmailContactMethod("Email-Official", "jdoe@example.com"))
+	prior["roles"] = []interface{}{"Admin"}
+	d := testUserData(t, prior, testUserConfig())
+	if !d.HasChange("contact_methods") || !d.HasChange("roles") {
+		t.Fatal("expected contact_methods and roles to change")
+	}
+
+	if diags := resourceUserUpdate(context.Background(), d, NewClient("key", server.URL)); diags.HasError() {
+		t.Fatalf("update failed: %v", diags)
+	}
+	for _, key := range []string{"contact_methods", "roles"} {
+		value, ok := server.lastPut[key].([]interface{})
+		if !ok || len(value) != 0 {
+			t.Errorf("PUT %s = %#v, want an empty array", key, server.lastPut[key])
+		}
+	}
+}
+
+func TestResourceUserReadDetectsContactMethodDrift(t *testing.T) {
+	// The contact method is in state and configuration but was deleted in AlertOps
+	server := newTestAPIServer(t, testUser())
+	config := testUserConfig(testEmailContactMethod("Email-Official", "jdoe@example.com"))
+	d := testUserData(t, config, config)
+
+	if diags := resourceUserRead(context.Background(), d, NewClient("key", server.URL)); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	// The next plan must add it back
+	r := resourceUser()
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), nil, nil, false)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	if diff == nil || diff.Attributes["contact_methods.#"] == nil {
+		t.Fatalf("plan after drift = %v, want contact_methods to change", diff)
+	}
+	if attr := diff.Attributes["contact_methods.#"]; attr.Old != "0" || attr.New != "1" {
+		t.Errorf("contact_methods.# planned %q -> %q, want 0 -> 1", attr.Old, attr.New)
+	}
+}
+
+func TestResourceUserDeleteDeactivateDisablesContactMethods(t *testing.T) {
+	server := newTestAPIServer(t, testUser(ContactMethod{
+		ContactMethodName: "Email-Official",
+		Email:             &EmailContact{EmailAddress: "jdoe@example.com"},
+		Enabled:           true,
+	}))
+	config := testUserConfig(testEmailContactMethod("Email-Official", "jdoe@example.com"))
+	config["deletion_policy"] = "deactivate"
+	d := schema.TestResourceDataRaw(t, resourceUser().Schema, config)
+	d.SetId("42")
+
+	if diags := resourceUserDelete(context.Background(), d, NewClient("key", server.URL)); diags.HasError() {
+		t.Fatalf("delete failed: %v", diags)
+	}
+	methods, _ := server.lastPut["contact_methods"].([]interface{})
+	if len(methods) != 1 {
+		t.Fatalf("PUT contact_methods = %#v, want the 1 existing method", server.lastPut["contact_methods"])
+	}
+	if enabled, ok := methods[0].(map[string]interface{})["enabled"]; !ok || enabled != false {
+		t.Errorf("PUT enabled = %v (present: %v), want false", enabled, ok)
+	}
+	if d.Id() != "" {
+		t.Errorf("ID = %q, want the user removed from state", d.Id())
+	}
+}
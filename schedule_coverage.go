@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Default length, in days, of the window schedule coverage is checked over
+const defaultScheduleCoverageDays = 7
+
+// Most shifts of one schedule expanded for a coverage check
+const maxCoverageShifts = 10000
+
+// ScheduleInterval is a period covered by a given set of schedules
+type ScheduleInterval struct {
+	Start     time.Time
+	End       time.Time
+	Schedules []string // Empty for gaps
+}
+
+// ScheduleCoverage is the coverage of a group's schedules over a window
+type ScheduleCoverage struct {
+	Gaps      []ScheduleInterval
+	Overlaps  []ScheduleInterval
+	UserHours map[string]float64
+	Covered   time.Duration
+}
+
+// analyzeScheduleCoverage expands schedules over [from, to) and reports the
+// periods no schedule covers, the periods two or more schedules cover, and how
+// many hours each user is on call. Shifts without users only count as coverage
+// for schedules that include all users in the group.
+func analyzeScheduleCoverage(schedules []Schedule, from, to time.Time) (ScheduleCoverage, error) {
+	type event struct {
+		at       time.Time
+		delta    int
+		schedule string
+	}
+
+	coverage := ScheduleCoverage{UserHours: make(map[string]float64)}
+	var events []event
+	for _, schedule := range schedules {
+		shifts, err := expandScheduleShiftsUntil(schedule, from, to, maxCoverageShifts)
+		if err != nil {
+			return coverage, fmt.Errorf("schedule %q: %w", schedule.ScheduleName, err)
+		}
+
+		for _, shift := range shifts {
+			start, end := shift.Start, shift.End
+			if start.Before(from) {
+				start = from
+			}
+			if end.IsZero() || end.After(to) {
+				end = to
+			}
+			if !end.After(start) || (len(shift.Users) == 0 && !schedule.IncludeAllUsersInGroup) {
+				continue
+			}
+
+			// Report times in from's time zone, whatever the schedule's
+			start, end = start.In(from.Location()), end.In(from.Location())
+			events = append(events, event{start, 1, schedule.ScheduleName}, event{end, -1, schedule.ScheduleName})
+			for _, user := range shift.Users {
+				coverage.UserHours[user] += end.Sub(start).Hours()
+			}
+		}
+	}
+
+	// Ends sort before starts at the same time so that back-to-back shifts don't
+	// count as overlapping
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].at.Equal(events[j].at) {
+			return events[i].at.Before(events[j].at)
+		}
+		return events[i].delta < events[j].delta
+	})
+
+	active := make(map[string]int)
+	last := from
+	record := func(until time.Time) {
+		if !until.After(last) {
+			return
+		}
+		var names []string
+		for name, n := range active {
+			if n > 0 {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		interval := ScheduleInterval{Start: last, End: until, Schedules: names}
+		switch {
+		case len(names) == 0:
+			coverage.Gaps = appendScheduleInterval(coverage.Gaps, interval)
+		case len(names) > 1:
+			coverage.Overlaps = appendScheduleInterval(coverage.Overlaps, interval)
+		}
+		if len(names) > 0 {
+			coverage.Covered += until.Sub(last)
+		}
+		last = until
+	}
+
+	for _, e := range events {
+		record(e.at)
+		active[e.schedule] += e.delta
+	}
+	record(to)
+
+	for user, hours := range coverage.UserHours {
+		coverage.UserHours[user] = math.Round(hours*100) / 100
+	}
+	return coverage, nil
+}
+
+// appendScheduleInterval appends an interval, merging it into the last one if it
+// follows on with the same schedules
+func appendScheduleInterval(intervals []ScheduleInterval, interval ScheduleInterval) []ScheduleInterval {
+	if n := len(intervals); n > 0 {
+		previous := &intervals[n-1]
+		if previous.End.Equal(interval.Start) && strings.Join(previous.Schedules, "\x00") == strings.Join(interval.Schedules, "\x00") {
+			previous.End = interval.End
+			return intervals
+		}
+	}
+	return append(intervals, interval)
+}
+
+// flattenScheduleIntervals converts ScheduleInterval structs to Terraform data
+func flattenScheduleIntervals(intervals []ScheduleInterval) []interface{} {
+	result := make([]interface{}, len(intervals))
+	for i, interval := range intervals {
+		schedules := make([]interface{}, len(interval.Schedules))
+		for j, name := range interval.Schedules {
+			schedules[j] = name
+		}
+		result[i] = map[string]interface{}{
+			"start":     formatShiftTime(interval.Start),
+			"end":       formatShiftTime(interval.End),
+			"hours":     math.Round(interval.End.Sub(interval.Start).Hours()*100) / 100,
+			"schedules": schedules,
+		}
+	}
+	return result
+}
+
+// formatScheduleGaps describes gaps for an error message, listing at most a few
+func formatScheduleGaps(gaps []ScheduleInterval) string {
+	const shown = 3
+
+	var parts []string
+	for i, gap := range gaps {
+		if i == shown {
+			parts = append(parts, fmt.Sprintf("and %d more", len(gaps)-shown))
+			break
+		}
+		parts = append(parts, fmt.Sprintf("%s to %s", formatShiftTime(gap.Start), formatShiftTime(gap.End)))
+	}
+	return strings.Join(parts, ", ")
+}
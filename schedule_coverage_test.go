@@ -0,0 +1,141 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testFixedSchedule(name string, weekdays *ScheduleWeekdays, startHour, endHour int, users ...string) Schedule {
+	schedule := Schedule{
+		ScheduleName:     name,
+		ScheduleType:     "Fixed",
+		TimeZone:         "UTC",
+		StartDate:        &ScheduleDate{Date: "2024-01-01", Hour: startHour},
+		EndDate:          &ScheduleDate{Date: "2030-01-01", Hour: endHour},
+		ScheduleWeekdays: weekdays,
+	}
+	for _, user := range users {
+		schedule.Users = append(schedule.Users, ScheduleUser{User: user})
+	}
+	return schedule
+}
+
+func testInterval(start, end string, schedules ...string) ScheduleInterval {
+	s, _ := time.Parse(time.RFC3339, start)
+	e, _ := time.Parse(time.RFC3339, end)
+	return ScheduleInterval{Start: s, End: e, Schedules: schedules}
+}
+
+func TestAnalyzeScheduleCoverage(t *testing.T) {
+	workdays := &ScheduleWeekdays{Mon: true, Tue: true, Wed: true, Thu: true, Fri: true}
+
+	// Rotates at 12:00 every day, so it hands over in the middle of "day"
+	rotating := testRotatingSchedule("2023-12-31", 12, "daily")
+	rotating.ScheduleName = "rotating"
+	rotating.TimeZone = "UTC"
+	rotating.Users = rotating.Users[:2]
+
+	noUsers := testFixedSchedule("no users", workdays, 0, 9)
+	allUsers := testFixedSchedule("all users", workdays, 0, 9)
+	allUsers.IncludeAllUsersInGroup = true
+
+	cases := []struct {
+		name      string
+		schedules []Schedule
+		to        string
+		gaps      []ScheduleInterval
+		overlaps  []ScheduleInterval
+		userHours map[string]float64
+		covered   time.Duration
+	}{
+		{
+			name: "back-to-back day and overnight schedules",
+			schedules: []Schedule{
+				testFixedSchedule("day", workdays, 9, 17, "alice"),
+				testFixedSchedule("night", workdays, 17, 9, "bob"),
+			},
+			to:        "2024-01-03T00:00:00Z",
+			gaps:      []ScheduleInterval{testInterval("2024-01-01T00:00:00Z", "2024-01-01T09:00:00Z")},
+			userHours: map[string]float64{"alice": 16, "bob": 23},
+			covered:   39 * time.Hour,
+		},
+		{
+			name: "overlap is merged across a hand-over",
+			schedules: []Schedule{
+				rotating,
+				testFixedSchedule("day", workdays, 9, 17, "carol"),
+			},
+			to:        "2024-01-02T00:00:00Z",
+			overlaps:  []ScheduleInterval{testInterval("2024-01-01T09:00:00Z", "2024-01-01T17:00:00Z", "day", "rotating")},
+			userHours: map[string]float64{"alice": 12, "bob": 12, "carol": 8},
+			covered:   24 * time.Hour,
+		},
+		{
+			name:      "shifts without users are gaps unless all users are included",
+			schedules: []Schedule{noUsers, allUsers},
+			to:        "2024-01-01T12:00:00Z",
+			gaps:      []ScheduleInterval{testInterval("2024-01-01T09:00:00Z", "2024-01-01T12:00:00Z")},
+			userHours: map[string]float64{},
+			covered:   9 * time.Hour,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			to, _ := time.Parse(time.RFC3339, c.to)
+			coverage, err := analyzeScheduleCoverage(c.schedules, from, to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(coverage.Gaps, c.gaps) {
+				t.Errorf("got gaps %v, want %v", coverage.Gaps, c.gaps)
+			}
+			if !reflect.DeepEqual(coverage.Overlaps, c.overlaps) {
+				t.Errorf("got overlaps %v, want %v", coverage.Overlaps, c.overlaps)
+			}
+			if !reflect.DeepEqual(coverage.UserHours, c.userHours) {
+				t.Errorf("got user hours %v, want %v", coverage.UserHours, c.userHours)
+			}
+			if coverage.Covered != c.covered {
+				t.Errorf("got %s covered, want %s", coverage.Covered, c.covered)
+			}
+		})
+	}
+}
+
+func TestAppendScheduleInterval(t *testing.T) {
+	intervals := []ScheduleInterval{testInterval("2024-01-01T00:00:00Z", "2024-01-01T09:00:00Z", "a", "b")}
+
+	// Follows on with the same schedules, so it's merged
+	intervals = appendScheduleInterval(intervals, testInterval("2024-01-01T09:00:00Z", "2024-01-01T10:00:00Z", "a", "b"))
+	// Different schedules
+	intervals = appendScheduleInterval(intervals, testInterval("2024-01-01T10:00:00Z", "2024-01-01T11:00:00Z", "a", "c"))
+	// Same schedules but not following on
+	intervals = appendScheduleInterval(intervals, testInterval("2024-01-01T12:00:00Z", "2024-01-01T13:00:00Z", "a", "c"))
+
+	want := []ScheduleInterval{
+		testInterval("2024-01-01T00:00:00Z", "2024-01-01T10:00:00Z", "a", "b"),
+		testInterval("2024-01-01T10:00:00Z", "2024-01-01T11:00:00Z", "a", "c"),
+		testInterval("2024-01-01T12:00:00Z", "2024-01-01T13:00:00Z", "a", "c"),
+	}
+	if !reflect.DeepEqual(intervals, want) {
+		t.Errorf("got %v, want %v", intervals, want)
+	}
+}
+
+func TestFormatScheduleGaps(t *testing.T) {
+	var gaps []ScheduleInterval
+	for _, hour := range []string{"01", "03", "05", "07"} {
+		gaps = append(gaps, testInterval("2024-01-01T"+hour+":00:00Z", "2024-01-01T"+hour+":30:00Z"))
+	}
+
+	want := "2024-01-01T01:00:00Z to 2024-01-01T01:30:00Z, 2024-01-01T03:00:00Z to 2024-01-01T03:30:00Z, " +
+		"2024-01-01T05:00:00Z to 2024-01-01T05:30:00Z, and 1 more"
+	if got := formatScheduleGaps(gaps); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := formatScheduleGaps(gaps[:1]); got != "2024-01-01T01:00:00Z to 2024-01-01T01:30:00Z" {
+		t.Errorf("got %q for one gap", got)
+	}
+}
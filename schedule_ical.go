@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Local date-time format of iCalendar (RFC 5545) properties
+const icalLocalTimeLayout = "20060102T150405"
+
+// Longest iCalendar content line, in octets, before folding
+const icalLineLength = 75
+
+// icalCalendar is an iCalendar document of schedule shifts
+type icalCalendar struct {
+	Name     string
+	Schedule Schedule
+	Shifts   []ScheduleShift
+	Location *time.Location
+	From     time.Time
+	To       time.Time
+}
+
+// render returns the calendar as an RFC 5545 document. Event times use the
+// schedule's time zone, described by a VTIMEZONE covering the calendar's window.
+// The output depends only on the calendar's contents, so that it doesn't change
+// between reads.
+func (c icalCalendar) render() string {
+	tzid := c.Location.String()
+	stamp := c.From.UTC().Format(icalLocalTimeLayout) + "Z"
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//AlertOps//Terraform Provider//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + icalEscape(c.Name),
+		"X-WR-TIMEZONE:" + tzid,
+	}
+	lines = append(lines, icalTimeZone(c.Location, c.From, c.To)...)
+
+	for _, shift := range c.Shifts {
+		end := shift.End
+		if end.IsZero() {
+			end = c.To
+		}
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			fmt.Sprintf("UID:%d-%sZ@alertops", c.Schedule.ScheduleID, shift.Start.UTC().Format(icalLocalTimeLayout)),
+			"DTSTAMP:"+stamp,
+			fmt.Sprintf("DTSTART;TZID=%s:%s", tzid, shift.Start.In(c.Location).Format(icalLocalTimeLayout)),
+			fmt.Sprintf("DTEND;TZID=%s:%s", tzid, end.In(c.Location).Format(icalLocalTimeLayout)),
+			"SUMMARY:"+icalEscape(fmt.Sprintf("On call (%s): %s", c.Schedule.ScheduleName, strings.Join(shift.Users, ", "))),
+			"DESCRIPTION:"+icalEscape(fmt.Sprintf("Group: %s\nSchedule: %s\nOn call: %s", c.Schedule.Group, c.Schedule.ScheduleName, strings.Join(shift.Users, ", "))),
+			"TRANSP:TRANSPARENT",
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(icalFold(line))
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+// icalTimeZone returns a VTIMEZONE for a location with an observance for the
+// offset in effect at from and one for each change of offset up to to
+func icalTimeZone(location *time.Location, from, to time.Time) []string {
+	type transition struct {
+		at         time.Time
+		offsetFrom int
+	}
+
+	// Start with the last change before from, looking back a year
+	var transitions []transition
+	previous := from.AddDate(-1, 0, 0).In(location)
+	_, previousOffset := previous.Zone()
+	for day := previous.AddDate(0, 0, 1); !day.After(to.AddDate(0, 0, 1)); day = day.AddDate(0, 0, 1) {
+		if _, offset := day.Zone(); offset != previousOffset {
+			// Narrow the change down to the second
+			low, high := previous, day
+			for high.Sub(low) > time.Second {
+				middle := low.Add(high.Sub(low) / 2)
+				if _, o := middle.Zone(); o == previousOffset {
+					low = middle
+				} else {
+					high = middle
+				}
+			}
+			if !high.After(from) {
+				transitions = transitions[:0]
+			}
+			transitions = append(transitions, transition{at: high, offsetFrom: previousOffset})
+			previousOffset = offset
+		}
+		previous = day
+	}
+
+	lines := []string{"BEGIN:VTIMEZONE", "TZID:" + location.String()}
+	observance := func(at time.Time, offsetFrom int, dtstart string) {
+		name, offset := at.Zone()
+		kind := "STANDARD"
+		if at.IsDST() {
+			kind = "DAYLIGHT"
+		}
+		lines = append(lines,
+			"BEGIN:"+kind,
+			"DTSTART:"+dtstart,
+			"TZOFFSETFROM:"+icalOffset(offsetFrom),
+			"TZOFFSETTO:"+icalOffset(offset),
+			"TZNAME:"+icalEscape(name),
+			"END:"+kind,
+		)
+	}
+
+	if len(transitions) == 0 || transitions[0].at.After(from) {
+		// No change before from that we know of; describe the offset at from
+		at := from.In(location)
+		_, offset := at.Zone()
+		observance(at, offset, "19700101T000000")
+	}
+	for _, t := range transitions {
+		// DTSTART is the local time of the change, before it takes effect
+		local := t.at.In(time.FixedZone("", t.offsetFrom))
+		observance(t.at.In(location), t.offsetFrom, local.Format(icalLocalTimeLayout))
+	}
+
+	return append(lines, "END:VTIMEZONE")
+}
+
+// icalOffset formats a UTC offset in seconds as +HHMM, or +HHMMSS if needed
+func icalOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	if seconds%60 != 0 {
+		return fmt.Sprintf("%s%02d%02d%02d", sign, seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+}
+
+// icalEscape escapes TEXT property values
+func icalEscape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value)
+}
+
+// icalFold splits a content line into lines of at most 75 octets, continuing
+// each with a space, without splitting UTF-8 characters
+func icalFold(line string) string {
+	var b strings.Builder
+	limit := icalLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icalLineLength - 1
+	}
+	b.WriteString(line)
+	return b.String()
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestICalTimeZone(t *testing.T) {
+	cases := []struct {
+		zone     string
+		from, to time.Time
+		want     []string
+	}{
+		{
+			zone: "America/New_York",
+			from: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC),
+			want: []string{
+				"BEGIN:VTIMEZONE", "TZID:America/New_York",
+				// The change before from that is in effect at from
+				"BEGIN:STANDARD", "DTSTART:20231105T020000", "TZOFFSETFROM:-0400", "TZOFFSETTO:-0500", "TZNAME:EST", "END:STANDARD",
+				"BEGIN:DAYLIGHT", "DTSTART:20240310T020000", "TZOFFSETFROM:-0500", "TZOFFSETTO:-0400", "TZNAME:EDT", "END:DAYLIGHT",
+				"BEGIN:STANDARD", "DTSTART:20241103T020000", "TZOFFSETFROM:-0400", "TZOFFSETTO:-0500", "TZNAME:EST", "END:STANDARD",
+				"END:VTIMEZONE",
+			},
+		},
+		{
+			zone: "Europe/London",
+			from: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+			want: []string{
+				"BEGIN:VTIMEZONE", "TZID:Europe/London",
+				"BEGIN:DAYLIGHT", "DTSTART:20240331T010000", "TZOFFSETFROM:+0000", "TZOFFSETTO:+0100", "TZNAME:BST", "END:DAYLIGHT",
+				"END:VTIMEZONE",
+			},
+		},
+		{
+			zone: "Asia/Kolkata",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			want: []string{
+				"BEGIN:VTIMEZONE", "TZID:Asia/Kolkata",
+				"BEGIN:STANDARD", "DTSTART:19700101T000000", "TZOFFSETFROM:+0530", "TZOFFSETTO:+0530", "TZNAME:IST", "END:STANDARD",
+				"END:VTIMEZONE",
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.zone, func(t *testing.T) {
+			location, err := time.LoadLocation(c.zone)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := icalTimeZone(location, c.from, c.to); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(c.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestICalOffset(t *testing.T) {
+	cases := map[int]string{
+		0:      "+0000",
+		19800:  "+0530",
+		-12600: "-0330",
+		-18000: "-0500",
+		-17762: "-045602",
+	}
+	for seconds, want := range cases {
+		if got := icalOffset(seconds); got != want {
+			t.Errorf("icalOffset(%d) = %q, want %q", seconds, got, want)
+		}
+	}
+}
+
+func TestICalEscape(t *testing.T) {
+	got := icalEscape("On call (Ops; EU): a, b\\c\r\nnext\nline")
+	want := `On call (Ops\; EU): a\, b\\c\nnext\nline`
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestICalFold(t *testing.T) {
+	cases := []struct {
+		name string
+		line string
+	}{
+		{"short", "SUMMARY:On call"},
+		{"exactly 75 octets", "SUMMARY:" + strings.Repeat("a", 67)},
+		{"long", "DESCRIPTION:" + strings.Repeat("abcdefghij", 20)},
+		// 3-octet characters that don't line up with the fold points
+		{"multibyte", "SUMMARY:" + strings.Repeat("日本語", 30)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			folded := icalFold(c.line)
+			lines := strings.Split(folded, "\r\n")
+			for i, line := range lines {
+				if len(line) > icalLineLength {
+					t.Errorf("line %d is %d octets", i, len(line))
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("continuation line %d doesn't start with a space: %q", i, line)
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d splits a UTF-8 character", i)
+				}
+			}
+			if len(c.line) <= icalLineLength && len(lines) != 1 {
+				t.Errorf("got %d lines, want 1", len(lines))
+			}
+			if unfolded := strings.ReplaceAll(folded, "\r\n ", ""); unfolded != c.line {
+				t.Errorf("unfolds to %q, want %q", unfolded, c.line)
+			}
+		})
+	}
+}
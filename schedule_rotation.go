@@ -0,0 +1,419 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// Default number of shifts in a rotation preview
+const defaultRotationPreviewShifts = 10
+
+// How far past the start (or the from time) a rotation preview looks for shifts
+const rotationPreviewHorizonYears = 3
+
+// ScheduleShift is a period in which the same users are on call
+type ScheduleShift struct {
+	Start time.Time
+	End   time.Time // Zero if the shift doesn't end
+	Users []string
+}
+
+// expandScheduleShifts computes, locally, the first count shifts of a schedule
+// that end after from. Coverage comes from continuous, schedule_weekdays or
+// start_weekday/end_weekday, thinned by repeat_schedule; each coverage window is
+// split where the rotation hands over to the next rotate_x_users users. A zero
+// from means the schedule's start. Users added through include_all_users_in_group
+// aren't known locally and aren't shown.
+func expandScheduleShifts(schedule Schedule, from time.Time, count int) ([]ScheduleShift, error) {
+	return expandScheduleShiftsUntil(schedule, from, time.Time{}, count)
+}
+
+// expandScheduleShiftsUntil is expandScheduleShifts, also stopping at shifts that
+// start at or after until unless until is zero
+func expandScheduleShiftsUntil(schedule Schedule, from, until time.Time, count int) ([]ScheduleShift, error) {
+	if schedule.StartDate == nil || count <= 0 {
+		return nil, nil
+	}
+
+	location := timeZoneLocation(schedule.TimeZone)
+	start, err := scheduleDateTime(schedule.StartDate, location)
+	if err != nil {
+		return nil, fmt.Errorf("start_date: %v", err)
+	}
+	if from.IsZero() || from.Before(start) {
+		from = start
+	}
+
+	// The schedule ends at end_date or after repeat_until_date, whichever is first;
+	// otherwise shifts are only looked for up to the horizon
+	limit := from.AddDate(rotationPreviewHorizonYears, 0, 0)
+	bounded := false
+	if schedule.EndDate != nil {
+		end, err := scheduleDateTime(schedule.EndDate, location)
+		if err != nil {
+			return nil, fmt.Errorf("end_date: %v", err)
+		}
+		limit, bounded = earlierTime(limit, end), true
+	}
+	if schedule.RepeatSchedule != nil && schedule.RepeatSchedule.RepeatUntilDate != "" {
+		until, err := time.ParseInLocation(scheduleDateLayout, schedule.RepeatSchedule.RepeatUntilDate, location)
+		if err != nil {
+			return nil, fmt.Errorf("repeat_schedule.0.repeat_until_date: %v", err)
+		}
+		limit, bounded = earlierTime(limit, until.AddDate(0, 0, 1)), true
+	}
+	if !limit.After(start) {
+		return nil, nil
+	}
+
+	rotation, err := newScheduleRotation(schedule, start, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var shifts []ScheduleShift
+	windows := newScheduleCoverage(schedule, start, limit, location)
+	for len(shifts) < count {
+		windowStart, windowEnd, ok := windows.next()
+		if !ok {
+			break
+		}
+		if !until.IsZero() && !windowStart.Before(until) {
+			break
+		}
+		if !windowEnd.After(from) {
+			continue
+		}
+
+		// Split the window at rotation hand-overs
+		for s := windowStart; s.Before(windowEnd) && len(shifts) < count; {
+			if !until.IsZero() && !s.Before(until) {
+				break
+			}
+			users, handover := rotation.at(s)
+			e := windowEnd
+			if !handover.IsZero() && handover.Before(e) {
+				e = handover
+			}
+
+			if e.After(from) {
+				shift := ScheduleShift{Start: s, End: e, Users: users}
+				if !bounded && e.Equal(limit) {
+					shift.End = time.Time{}
+				}
+				shifts = append(shifts, shift)
+			}
+			s = e
+		}
+	}
+	return shifts, nil
+}
+
+// scheduleCoverage yields the windows in which a schedule is active, in order
+type scheduleCoverage struct {
+	start    time.Time
+	limit    time.Time
+	location *time.Location
+	day      time.Time // Next day to look at for weekday windows
+	done     bool
+
+	continuous   bool
+	weekdays     [7]bool // Days with their own daily window, from schedule_weekdays
+	weekly       bool    // One window a week, from start_weekday to end_weekday
+	startWeekday time.Weekday
+	endWeekday   time.Weekday
+	startTime    ScheduleTime
+	endTime      ScheduleTime
+	everyXWeeks  int
+}
+
+func newScheduleCoverage(schedule Schedule, start, limit time.Time, location *time.Location) *scheduleCoverage {
+	c := &scheduleCoverage{
+		start:       start,
+		limit:       limit,
+		location:    location,
+		day:         time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, location),
+		continuous:  true,
+		startTime:   ScheduleTime{Hour: schedule.StartDate.Hour, Minute: schedule.StartDate.Minute},
+		endTime:     ScheduleTime{Hour: schedule.StartDate.Hour, Minute: schedule.StartDate.Minute},
+		everyXWeeks: 1,
+	}
+	if schedule.EndDate != nil {
+		c.endTime = ScheduleTime{Hour: schedule.EndDate.Hour, Minute: schedule.EndDate.Minute}
+	}
+	if schedule.RepeatSchedule != nil && schedule.RepeatSchedule.EveryXWeeks > 1 {
+		c.everyXWeeks = schedule.RepeatSchedule.EveryXWeeks
+	}
+	if schedule.Continuous {
+		return c
+	}
+
+	if w := schedule.ScheduleWeekdays; w != nil {
+		c.weekdays = [7]bool{w.Sun, w.Mon, w.Tue, w.Wed, w.Thu, w.Fri, w.Sat}
+		for _, selected := range c.weekdays {
+			if selected {
+				c.continuous = false
+			}
+		}
+	}
+	if c.continuous && (schedule.StartWeekday != "" || schedule.EndWeekday != "") {
+		c.continuous, c.weekly = false, true
+		c.startWeekday = scheduleWeekday(schedule.StartWeekday, schedule.EndWeekday)
+		c.endWeekday = scheduleWeekday(schedule.EndWeekday, schedule.StartWeekday)
+	}
+	return c
+}
+
+// next returns the next coverage window, clipped to the schedule's start and limit
+func (c *scheduleCoverage) next() (time.Time, time.Time, bool) {
+	if c.done {
+		return time.Time{}, time.Time{}, false
+	}
+	if c.continuous {
+		c.done = true
+		return c.start, c.limit, true
+	}
+
+	for c.day.Before(c.limit) {
+		day := c.day
+		c.day = day.AddDate(0, 0, 1)
+
+		if (daysBetween(c.start, day)/7)%c.everyXWeeks != 0 {
+			continue
+		}
+
+		var windowEnd time.Time
+		switch {
+		case c.weekly:
+			if day.Weekday() != c.startWeekday {
+				continue
+			}
+			endDay := day.AddDate(0, 0, (int(c.endWeekday)-int(c.startWeekday)+7)%7)
+			windowEnd = atScheduleTime(endDay, c.endTime, c.location)
+		case c.weekdays[day.Weekday()]:
+			windowEnd = atScheduleTime(day, c.endTime, c.location)
+		default:
+			continue
+		}
+
+		windowStart := atScheduleTime(day, c.startTime, c.location)
+		if !windowEnd.After(windowStart) {
+			if c.weekly {
+				windowEnd = windowEnd.AddDate(0, 0, 7)
+			} else {
+				windowEnd = windowEnd.AddDate(0, 0, 1)
+			}
+		}
+
+		if windowStart.Before(c.start) {
+			windowStart = c.start
+		}
+		windowEnd = earlierTime(windowEnd, c.limit)
+		if windowEnd.After(windowStart) {
+			return windowStart, windowEnd, true
+		}
+	}
+
+	c.done = true
+	return time.Time{}, time.Time{}, false
+}
+
+// scheduleRotation tells which users are on call at a time. Without a rotation
+// every user is on call all the time.
+type scheduleRotation struct {
+	users        []string
+	rotateXUsers int
+	start        time.Time
+	location     *time.Location
+	anchor       time.Time // First rotation time at or after the start
+	step         func(anchor time.Time, n int) time.Time
+
+	// Position of the last call to at, so that calls with increasing times step
+	// forward from the last hand-over instead of from the anchor
+	last     time.Time
+	n        int       // Rotation times stepped past the anchor
+	index    int       // Hand-overs after the start up to last
+	handover time.Time // Rotation time n
+}
+
+func newScheduleRotation(schedule Schedule, start time.Time, location *time.Location) (*scheduleRotation, error) {
+	r := &scheduleRotation{start: start, location: location}
+	for _, user := range schedule.Users {
+		r.users = append(r.users, user.User)
+	}
+	if schedule.ScheduleType != "Rotating" || schedule.RotateFrequency == "" {
+		return r, nil
+	}
+
+	rotateAt := ScheduleTime{Hour: start.Hour(), Minute: start.Minute()}
+	startDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, location)
+	switch schedule.RotateFrequency {
+	case "daily":
+		rotation := schedule.RotateDaily
+		if rotation == nil {
+			return nil, fmt.Errorf("rotate_frequency = %q requires a rotate_daily block", schedule.RotateFrequency)
+		}
+		if rotation.RotateAtTime != nil {
+			rotateAt = *rotation.RotateAtTime
+		}
+		every := atLeastOne(rotation.EveryXDays)
+		r.rotateXUsers = rotation.RotateXUsers
+		r.anchor = atScheduleTime(startDay, rotateAt, location)
+		if r.anchor.Before(start) {
+			r.anchor = atScheduleTime(startDay.AddDate(0, 0, 1), rotateAt, location)
+		}
+		r.step = func(anchor time.Time, n int) time.Time {
+			return atScheduleTime(anchor.AddDate(0, 0, n*every), rotateAt, location)
+		}
+	case "weekly":
+		rotation := schedule.RotateWeekly
+		if rotation == nil {
+			return nil, fmt.Errorf("rotate_frequency = %q requires a rotate_weekly block", schedule.RotateFrequency)
+		}
+		if rotation.RotateAtTime != nil {
+			rotateAt = *rotation.RotateAtTime
+		}
+		every := atLeastOne(rotation.EveryXWeeks)
+		weekday := scheduleWeekday(rotation.RotateAtDayOfWeek, "")
+		r.rotateXUsers = rotation.RotateXUsers
+		r.anchor = atScheduleTime(startDay.AddDate(0, 0, (int(weekday)-int(startDay.Weekday())+7)%7), rotateAt, location)
+		if r.anchor.Before(start) {
+			r.anchor = atScheduleTime(r.anchor.AddDate(0, 0, 7), rotateAt, location)
+		}
+		r.step = func(anchor time.Time, n int) time.Time {
+			return atScheduleTime(anchor.AddDate(0, 0, 7*n*every), rotateAt, location)
+		}
+	case "monthly":
+		rotation := schedule.RotateMonthly
+		if rotation == nil {
+			return nil, fmt.Errorf("rotate_frequency = %q requires a rotate_monthly block", schedule.RotateFrequency)
+		}
+		if rotation.RotateAtTime != nil {
+			rotateAt = *rotation.RotateAtTime
+		}
+		every := atLeastOne(rotation.EveryXMonths)
+		r.rotateXUsers = rotation.RotateXUsers
+		r.anchor = atScheduleTime(startDay, rotateAt, location)
+		if r.anchor.Before(start) {
+			r.anchor = atScheduleTime(addMonthsClamped(startDay, 1), rotateAt, location)
+		}
+		r.step = func(anchor time.Time, n int) time.Time {
+			return atScheduleTime(addMonthsClamped(anchor, n*every), rotateAt, location)
+		}
+	default:
+		return nil, fmt.Errorf("rotate_frequency must be one of %v, got: %q", RotateFrequencies, schedule.RotateFrequency)
+	}
+
+	r.rotateXUsers = atLeastOne(r.rotateXUsers)
+	if r.rotateXUsers > len(r.users) {
+		r.rotateXUsers = len(r.users)
+	}
+	return r, nil
+}
+
+// at returns the users on call at t and the time of the next hand-over, which is
+// zero without a rotation
+func (r *scheduleRotation) at(t time.Time) ([]string, time.Time) {
+	if r.step == nil || len(r.users) == 0 {
+		return r.users, time.Time{}
+	}
+
+	if r.handover.IsZero() || t.Before(r.last) {
+		r.n, r.index, r.handover = 0, 0, r.anchor
+	}
+	r.last = t
+
+	// Rotations happen at the rotation times after the start
+	for {
+		if r.handover.After(r.start) {
+			if r.handover.After(t) {
+				break
+			}
+			r.index++
+		}
+		r.n++
+		r.handover = r.step(r.anchor, r.n)
+	}
+
+	users := make([]string, r.rotateXUsers)
+	for i := range users {
+		users[i] = r.users[(r.index*r.rotateXUsers+i)%len(r.users)]
+	}
+	return users, r.handover
+}
+
+// scheduleWeekday returns the weekday named value, or fallback if value is empty.
+// Sunday is returned if neither names a weekday.
+func scheduleWeekday(value, fallback string) time.Weekday {
+	if value == "" {
+		value = fallback
+	}
+	for i, name := range ScheduleWeekdayNames {
+		if name == value {
+			return time.Weekday(i)
+		}
+	}
+	return time.Sunday
+}
+
+// atScheduleTime returns day at a time of day in a location
+func atScheduleTime(day time.Time, at ScheduleTime, location *time.Location) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), at.Hour, at.Minute, 0, 0, location)
+}
+
+// addMonthsClamped adds months to a day, keeping to the last day of shorter months
+func addMonthsClamped(day time.Time, months int) time.Time {
+	first := time.Date(day.Year(), day.Month()+time.Month(months), 1, 0, 0, 0, 0, day.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	if day.Day() < last {
+		last = day.Day()
+	}
+	return time.Date(first.Year(), first.Month(), last, day.Hour(), day.Minute(), 0, 0, day.Location())
+}
+
+// daysBetween returns the number of calendar days from a to b
+func daysBetween(a, b time.Time) int {
+	dayA := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	dayB := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(dayB.Sub(dayA).Hours() / 24)
+}
+
+func earlierTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func atLeastOne(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// flattenScheduleShifts converts ScheduleShift structs to Terraform data
+func flattenScheduleShifts(shifts []ScheduleShift) []interface{} {
+	result := make([]interface{}, len(shifts))
+	for i, shift := range shifts {
+		users := make([]interface{}, len(shift.Users))
+		for j, user := range shift.Users {
+			users[j] = user
+		}
+		result[i] = map[string]interface{}{
+			"start": formatShiftTime(shift.Start),
+			"end":   formatShiftTime(shift.End),
+			"users": users,
+		}
+	}
+	return result
+}
+
+// formatShiftTime formats a shift boundary in RFC 3339, or returns "" for the
+// zero time
+func formatShiftTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testRotatingSchedule(date string, hour int, frequency string) Schedule {
+	schedule := Schedule{
+		ScheduleType:    "Rotating",
+		Continuous:      true,
+		TimeZone:        "(UTC-05:00) Eastern Time (US & Canada)",
+		StartDate:       &ScheduleDate{Date: date, Hour: hour},
+		RotateFrequency: frequency,
+		Users:           []ScheduleUser{{User: "alice"}, {User: "bob"}, {User: "carol"}},
+	}
+	switch frequency {
+	case "daily":
+		schedule.RotateDaily = &RotateDaily{RotateXUsers: 1, EveryXDays: 1}
+	case "weekly":
+		schedule.RotateWeekly = &RotateWeekly{RotateXUsers: 1, EveryXWeeks: 1, RotateAtDayOfWeek: "Mon"}
+	case "monthly":
+		schedule.RotateMonthly = &RotateMonthly{RotateXUsers: 1, EveryXMonths: 1}
+	}
+	return schedule
+}
+
+func TestExpandScheduleShiftsAcrossDST(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name      string
+		date      string
+		durations []time.Duration
+	}{
+		// Clocks go forward at 02:00 on 10 March 2024
+		{"spring forward", "2024-03-09", []time.Duration{23 * time.Hour, 24 * time.Hour}},
+		// Clocks go back at 02:00 on 3 November 2024
+		{"fall back", "2024-11-02", []time.Duration{25 * time.Hour, 24 * time.Hour}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			shifts, err := expandScheduleShifts(testRotatingSchedule(c.date, 9, "daily"), time.Time{}, 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(shifts) != 3 {
+				t.Fatalf("got %d shifts, want 3", len(shifts))
+			}
+			for i, shift := range shifts {
+				local := shift.Start.In(newYork)
+				if local.Hour() != 9 || local.Minute() != 0 {
+					t.Errorf("shift %d starts at %s, want 09:00 local time", i, local)
+				}
+				if i < len(c.durations) {
+					if got := shift.End.Sub(shift.Start); got != c.durations[i] {
+						t.Errorf("shift %d lasts %s, want %s", i, got, c.durations[i])
+					}
+				}
+			}
+			users := [][]string{shifts[0].Users, shifts[1].Users, shifts[2].Users}
+			want := [][]string{{"alice"}, {"bob"}, {"carol"}}
+			if !reflect.DeepEqual(users, want) {
+				t.Errorf("got users %v, want %v", users, want)
+			}
+		})
+	}
+}
+
+func TestExpandScheduleShiftsRotations(t *testing.T) {
+	cases := []struct {
+		name      string
+		schedule  Schedule
+		from      time.Time
+		until     time.Time
+		count     int
+		want      []string // Shift starts in RFC 3339
+		wantUsers []string // First user of each shift
+	}{
+		{
+			name:      "weekly hands over on the rotation weekday",
+			schedule:  testRotatingSchedule("2024-01-03", 9, "weekly"), // A Wednesday
+			count:     3,
+			want:      []string{"2024-01-03T09:00:00-05:00", "2024-01-08T09:00:00-05:00", "2024-01-15T09:00:00-05:00"},
+			wantUsers: []string{"alice", "bob", "carol"},
+		},
+		{
+			name:      "monthly from the 31st keeps to the end of shorter months",
+			schedule:  testRotatingSchedule("2024-01-31", 9, "monthly"),
+			count:     4,
+			want:      []string{"2024-01-31T09:00:00-05:00", "2024-02-29T09:00:00-05:00", "2024-03-31T09:00:00-04:00", "2024-04-30T09:00:00-04:00"},
+			wantUsers: []string{"alice", "bob", "carol", "alice"},
+		},
+		{
+			name:      "from skips earlier shifts without changing the rotation",
+			schedule:  testRotatingSchedule("2024-01-01", 9, "daily"),
+			from:      time.Date(2024, 1, 4, 12, 0, 0, 0, time.UTC), // 07:00 in New York
+			count:     2,
+			want:      []string{"2024-01-03T09:00:00-05:00", "2024-01-04T09:00:00-05:00"},
+			wantUsers: []string{"carol", "alice"},
+		},
+		{
+			name:      "until stops at shifts starting at or after it",
+			schedule:  testRotatingSchedule("2024-01-01", 9, "daily"),
+			until:     time.Date(2024, 1, 3, 14, 0, 0, 0, time.UTC),
+			count:     10,
+			want:      []string{"2024-01-01T09:00:00-05:00", "2024-01-02T09:00:00-05:00"},
+			wantUsers: []string{"alice", "bob"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			shifts, err := expandScheduleShiftsUntil(c.schedule, c.from, c.until, c.count)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var starts, users []string
+			for _, shift := range shifts {
+				starts = append(starts, formatShiftTime(shift.Start))
+				users = append(users, shift.Users[0])
+			}
+			if !reflect.DeepEqual(starts, c.want) {
+				t.Errorf("got shift starts %v, want %v", starts, c.want)
+			}
+			if !reflect.DeepEqual(users, c.wantUsers) {
+				t.Errorf("got users %v, want %v", users, c.wantUsers)
+			}
+		})
+	}
+}
+
+func TestExpandScheduleShiftsErrors(t *testing.T) {
+	missing := testRotatingSchedule("2024-01-01", 9, "daily")
+	missing.RotateDaily = nil
+	badDate := testRotatingSchedule("01/01/2024", 9, "daily")
+
+	for name, schedule := range map[string]Schedule{"missing rotate_daily": missing, "bad start_date": badDate} {
+		if _, err := expandScheduleShifts(schedule, time.Time{}, 1); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestAddMonthsClamped(t *testing.T) {
+	cases := []struct {
+		day    string
+		months int
+		want   string
+	}{
+		{"2024-01-31", 1, "2024-02-29"},
+		{"2023-01-31", 1, "2023-02-28"},
+		{"2024-01-31", 2, "2024-03-31"},
+		{"2024-01-31", 3, "2024-04-30"},
+		{"2024-03-31", -1, "2024-02-29"},
+		{"2024-12-31", 2, "2025-02-28"},
+		{"2024-01-15", 1, "2024-02-15"},
+	}
+	for _, c := range cases {
+		day, err := time.Parse(scheduleDateLayout, c.day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := addMonthsClamped(day, c.months).Format(scheduleDateLayout); got != c.want {
+			t.Errorf("addMonthsClamped(%s, %d) = %s, want %s", c.day, c.months, got, c.want)
+		}
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := time.Date(2024, 3, 9, 23, 0, 0, 0, newYork)
+	b := time.Date(2024, 3, 11, 1, 0, 0, 0, newYork)
+	if got := daysBetween(a, b); got != 2 {
+		t.Errorf("daysBetween across the DST change = %d, want 2", got)
+	}
+}